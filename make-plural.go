@@ -1,12 +1,16 @@
 package main
 
 import (
+	"archive/zip"
+	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -27,11 +31,32 @@ type (
 
 	FuncSource struct {
 		culture, vars, impl string
+		// Cultures sharing the same rules, hence the same function
+		cultures []string
+		// Whether CLDR defines ordinal rules for these cultures
+		ordinal bool
+	}
+
+	// Locale data emitted along with the functions, see the `plural.Resolve` function
+	LocaleData struct {
+		// Explicit parents, eg. "pt-AO" => "pt-PT"
+		Parents map[string]string
+		// Replacements of deprecated language codes, eg. "iw" => "he"
+		Aliases map[string]string
+		// Categories of the ranges of each culture, eg. "one" to "other" is "other"
+		Ranges map[string][]PluralRange
+	}
+
+	// Categories of a range, as found in CLDR data, eg. "one"
+	PluralRange struct {
+		start, end, result string
 	}
 
 	UnitTestSource struct {
 		culture string
 		tests   []Test
+		// Rules as found in CLDR data, ordinals being nil when there are none
+		plurals, ordinals map[string]string
 	}
 
 	UnitTest struct {
@@ -39,8 +64,120 @@ type (
 		expected, value string
 	}
 
-	Op struct {
-		previous_logic, left, operator, right, next_logic string
+	RangeTest struct {
+		culture, expected, start, end string
+	}
+
+	// Plural rule syntax, see http://unicode.org/reports/tr35/tr35-numbers.html#Plural_rules_syntax
+	//
+	// condition       = and_condition ('or' and_condition)*
+	// and_condition   = relation ('and' relation)*
+	// relation        = is_relation | in_relation | within_relation
+	// is_relation     = expr 'is' ('not')? value
+	// in_relation     = expr (('not')? 'in' | '=' | '!=') range_list
+	// within_relation = expr ('not')? 'within' range_list
+	// expr            = operand (('mod' | '%') value)?
+	// operand         = 'n' | 'i' | 'f' | 't' | 'v' | 'w' | 'c' | 'e'
+	// range_list      = (range | value) (',' range_list)*
+	// range           = value'..'value
+	// samples         = ('@integer' sample_list)? ('@decimal' sample_list)?
+	// sample_list     = sample_range (',' sample_range)* (',' '…')?
+	// sample_range    = sample_value ('~' sample_value)?
+	// sample_value    = value ('.' value)? (('c' | 'e') value)?
+	Rule struct {
+		condition Condition
+		integers  Samples
+		decimals  Samples
+	}
+
+	// Relations of each `and_condition`, any of them may match
+	Condition [][]Relation
+
+	Relation struct {
+		operand  byte
+		modulus  int64
+		negated  bool
+		within   bool
+		ranges   []Range
+		position int
+	}
+
+	Range struct {
+		from, to int64
+	}
+
+	Samples struct {
+		ranges   []SampleRange
+		infinite bool
+	}
+
+	SampleRange struct {
+		from, to string
+	}
+
+	// Operands of a sample value, `n` being `i` unless `t` is not zero
+	Operands struct {
+		i, v, w, f, t, e int64
+	}
+
+	Token struct {
+		kind     int
+		text     string
+		position int
+	}
+
+	RuleParser struct {
+		input  string
+		tokens []Token
+		offset int
+	}
+
+	RuleError struct {
+		input    string
+		position int
+		message  string
+	}
+
+	// LDML supplemental data, as found in plurals.xml and ordinals.xml
+	XmlSupplementalData struct {
+		Version struct {
+			Number string `xml:"number,attr"`
+		} `xml:"version"`
+		Generation struct {
+			Date string `xml:"date,attr"`
+		} `xml:"generation"`
+		Plurals []struct {
+			Type  string `xml:"type,attr"`
+			Rules []struct {
+				Locales string `xml:"locales,attr"`
+				Rules   []struct {
+					Count string `xml:"count,attr"`
+					Rule  string `xml:",chardata"`
+				} `xml:"pluralRule"`
+			} `xml:"pluralRules"`
+			// Only found in pluralRanges.xml
+			Ranges []struct {
+				Locales string `xml:"locales,attr"`
+				Ranges  []struct {
+					Start  string `xml:"start,attr"`
+					End    string `xml:"end,attr"`
+					Result string `xml:"result,attr"`
+				} `xml:"pluralRange"`
+			} `xml:"pluralRanges"`
+		} `xml:"plurals"`
+		// Only found in supplementalMetadata.xml
+		LanguageAliases []struct {
+			Type        string `xml:"type,attr"`
+			Replacement string `xml:"replacement,attr"`
+		} `xml:"metadata>alias>languageAlias"`
+		// Only found in supplementalData.xml
+		ParentLocales []struct {
+			Component string `xml:"component,attr"`
+			Parents   []struct {
+				Parent  string `xml:"parent,attr"`
+				Locales string `xml:"locales,attr"`
+			} `xml:"parentLocale"`
+		} `xml:"parentLocales"`
 	}
 )
 
@@ -52,6 +189,34 @@ func (x FuncSource) CultureId() string {
 	return sanitize(x.culture)
 }
 
+func (x FuncSource) Cultures() []string {
+	return x.cultures
+}
+
+// Whether the function implements ordinal rules of its own
+func (x FuncSource) HasOrdinal() bool {
+	return x.ordinal
+}
+
+// Lists the cultures implemented by the function, wrapped on several lines
+func (x FuncSource) Comment() string {
+	var result []string
+
+	line := "//"
+	for idx, culture := range x.cultures {
+		if len(line)+len(culture) > 78 {
+			result = append(result, line)
+			line = "//"
+		}
+
+		line += " " + culture
+		if idx < len(x.cultures)-1 {
+			line += ","
+		}
+	}
+	return strings.Join(append(result, line), "\n")
+}
+
 func (x FuncSource) Code() string {
 	result := ""
 	if "" != x.vars {
@@ -61,6 +226,19 @@ func (x FuncSource) Code() string {
 	return result
 }
 
+// Category names as in the "plural" package, eg. "One"
+func (x PluralRange) Start() string {
+	return categoryName(x.start)
+}
+
+func (x PluralRange) End() string {
+	return categoryName(x.end)
+}
+
+func (x PluralRange) Result() string {
+	return categoryName(x.result)
+}
+
 func (x UnitTestSource) Culture() string {
 	return x.culture
 }
@@ -69,6 +247,26 @@ func (x UnitTestSource) CultureId() string {
 	return sanitize(x.culture)
 }
 
+// Go literal of the cardinal and ordinal rules, eg. `{One: "i = 1 and v = 0 @integer 1", ...}, nil`
+func (x UnitTestSource) Rules() string {
+	var result []string
+	for _, rules := range []map[string]string{x.plurals, x.ordinals} {
+		if nil == rules {
+			result = append(result, "nil")
+			continue
+		}
+
+		var items []string
+		for _, key := range []string{"zero", "one", "two", "few", "many", "other"} {
+			if rule, ok := rules["pluralRule-count-"+key]; ok {
+				items = append(items, categoryName(key)+": "+strconv.Quote(rule))
+			}
+		}
+		result = append(result, "{"+strings.Join(items, ", ")+"}")
+	}
+	return strings.Join(result, ", ")
+}
+
 func (x UnitTestSource) Code() string {
 	var result []string
 	for _, child := range x.tests {
@@ -87,6 +285,10 @@ func (x UnitTest) toString() string {
 	)
 }
 
+func (x RangeTest) toString() string {
+	return fmt.Sprintf("testRange(t, \"%s\", %s, %s, `%s`)", x.culture, x.start, x.end, x.expected)
+}
+
 func sanitize(input string) string {
 	var result string
 	for _, char := range input {
@@ -98,44 +300,534 @@ func sanitize(input string) string {
 	return result
 }
 
-func (x Op) conditions() []string {
+const (
+	tokenEnd = iota
+	tokenWord
+	tokenNumber
+	tokenSymbol
+	tokenSamples
+)
+
+func (x RuleError) Error() string {
+	return fmt.Sprintf("%s at column %d of `%s`", x.message, x.position+1, x.input)
+}
+
+func (x Token) String() string {
+	if tokenEnd == x.kind {
+		return "end of rule"
+	}
+	return "`" + x.text + "`"
+}
+
+func isDigit(char rune) bool {
+	return char >= '0' && char <= '9'
+}
+
+func tokenize(input string) ([]Token, error) {
+	var result []Token
+
+	chars := []rune(input)
+	max := len(chars)
+	for pos := 0; pos < max; {
+		char := chars[pos]
+		start := pos
+
+		switch {
+		case ' ' == char || '\t' == char || '\n' == char || '\r' == char:
+			pos++
+			continue
+
+		case char >= 'a' && char <= 'z':
+			for pos < max && chars[pos] >= 'a' && chars[pos] <= 'z' {
+				pos++
+			}
+			result = append(result, Token{tokenWord, string(chars[start:pos]), start})
+
+		case '@' == char:
+			pos++
+			for pos < max && chars[pos] >= 'a' && chars[pos] <= 'z' {
+				pos++
+			}
+			result = append(result, Token{tokenSamples, string(chars[start:pos]), start})
+
+		case isDigit(char):
+			for pos < max && isDigit(chars[pos]) {
+				pos++
+			}
+			// Sample values may have a fraction, eg. `1.50`, but `1..5` is a range
+			if pos+1 < max && '.' == chars[pos] && isDigit(chars[pos+1]) {
+				pos++
+				for pos < max && isDigit(chars[pos]) {
+					pos++
+				}
+			}
+			// ... and an exponent when using the compact decimal notation, eg. `1.5c6`
+			if pos+1 < max && ('c' == chars[pos] || 'e' == chars[pos]) && isDigit(chars[pos+1]) {
+				pos++
+				for pos < max && isDigit(chars[pos]) {
+					pos++
+				}
+			}
+			result = append(result, Token{tokenNumber, string(chars[start:pos]), start})
+
+		case '.' == char:
+			for pos < max && '.' == chars[pos] {
+				pos++
+			}
+
+			switch pos - start {
+			case 2:
+				result = append(result, Token{tokenSymbol, "..", start})
+			case 3:
+				result = append(result, Token{tokenSymbol, "\u2026", start})
+			default:
+				return nil, RuleError{input, start, "Unexpected `" + string(chars[start:pos]) + "`"}
+			}
+
+		case '!' == char:
+			if pos+1 >= max || '=' != chars[pos+1] {
+				return nil, RuleError{input, start, "Unexpected `!`"}
+			}
+			pos += 2
+			result = append(result, Token{tokenSymbol, "!=", start})
+
+		case '=' == char, '%' == char, ',' == char, '~' == char, '\u2026' == char:
+			pos++
+			result = append(result, Token{tokenSymbol, string(char), start})
+
+		default:
+			return nil, RuleError{input, start, "Unexpected `" + string(char) + "`"}
+		}
+	}
+	return append(result, Token{tokenEnd, "", max}), nil
+}
+
+func parseRule(input string) (Rule, error) {
+	var result Rule
+
+	tokens, err := tokenize(input)
+	if nil != err {
+		return result, err
+	}
+
+	parser := &RuleParser{input, tokens, 0}
+	if tokenEnd != parser.peek().kind && tokenSamples != parser.peek().kind {
+		result.condition, err = parser.condition()
+		if nil != err {
+			return result, err
+		}
+	}
+
+	for tokenSamples == parser.peek().kind {
+		token := parser.next()
+
+		samples, err := parser.samples()
+		if nil != err {
+			return result, err
+		}
+
+		switch token.text {
+		case "@integer":
+			result.integers = samples
+		case "@decimal":
+			result.decimals = samples
+		default:
+			return result, parser.fail(token, "Unknown sample type")
+		}
+	}
+
+	if token := parser.peek(); tokenEnd != token.kind {
+		return result, parser.fail(token, "Unexpected "+token.String())
+	}
+	return result, nil
+}
+
+func (x *RuleParser) peek() Token {
+	return x.tokens[x.offset]
+}
+
+func (x *RuleParser) next() Token {
+	token := x.tokens[x.offset]
+	if tokenEnd != token.kind {
+		x.offset++
+	}
+	return token
+}
+
+func (x *RuleParser) accept(kind int, text string) bool {
+	if token := x.peek(); kind == token.kind && text == token.text {
+		x.offset++
+		return true
+	}
+	return false
+}
+
+func (x *RuleParser) fail(token Token, message string) error {
+	return RuleError{x.input, token.position, message}
+}
+
+func (x *RuleParser) condition() (Condition, error) {
+	var result Condition
+	for {
+		var relations []Relation
+		for {
+			relation, err := x.relation()
+			if nil != err {
+				return nil, err
+			}
+			relations = append(relations, relation)
+
+			if !x.accept(tokenWord, "and") {
+				break
+			}
+		}
+		result = append(result, relations)
+
+		if !x.accept(tokenWord, "or") {
+			return result, nil
+		}
+	}
+}
+
+func (x *RuleParser) relation() (Relation, error) {
+	var result Relation
+
+	token := x.next()
+	if tokenWord != token.kind || 1 != len(token.text) || -1 == strings.Index("nifvtwce", token.text) {
+		return result, x.fail(token, "Expected an operand but got "+token.String())
+	}
+	result.operand = token.text[0]
+	// `c` and `e` are synonyms for the compact decimal exponent
+	if 'c' == result.operand {
+		result.operand = 'e'
+	}
+	result.position = token.position
+
+	if x.accept(tokenSymbol, "%") || x.accept(tokenWord, "mod") {
+		value, err := x.value()
+		if nil != err {
+			return result, err
+		}
+		if 0 == value {
+			return result, x.fail(x.tokens[x.offset-1], "Modulo by zero")
+		}
+		result.modulus = value
+	}
+
+	token = x.next()
+	switch {
+	case tokenSymbol == token.kind && "=" == token.text:
+
+	case tokenSymbol == token.kind && "!=" == token.text:
+		result.negated = true
+
+	case tokenWord == token.kind && "is" == token.text:
+		result.negated = x.accept(tokenWord, "not")
+
+		value, err := x.value()
+		if nil != err {
+			return result, err
+		}
+		result.ranges = []Range{{value, value}}
+		return result, nil
+
+	case tokenWord == token.kind && "not" == token.text:
+		result.negated = true
+		token = x.next()
+		if tokenWord != token.kind || ("in" != token.text && "within" != token.text) {
+			return result, x.fail(token, "Expected `in` or `within` but got "+token.String())
+		}
+		result.within = "within" == token.text
+
+	case tokenWord == token.kind && "in" == token.text:
+
+	case tokenWord == token.kind && "within" == token.text:
+		result.within = true
+
+	default:
+		return result, x.fail(token, "Expected a relation but got "+token.String())
+	}
+
+	for {
+		from, err := x.value()
+		if nil != err {
+			return result, err
+		}
+
+		to := from
+		if x.accept(tokenSymbol, "..") {
+			token = x.peek()
+			to, err = x.value()
+			if nil != err {
+				return result, err
+			}
+			if to < from {
+				return result, x.fail(token, "Empty range")
+			}
+		}
+		result.ranges = append(result.ranges, Range{from, to})
+
+		if !x.accept(tokenSymbol, ",") {
+			return result, nil
+		}
+	}
+}
+
+func (x *RuleParser) value() (int64, error) {
+	token := x.next()
+	if tokenNumber != token.kind || -1 != strings.IndexAny(token.text, ".ce") {
+		return 0, x.fail(token, "Expected an integer but got "+token.String())
+	}
+
+	result, err := strconv.ParseInt(token.text, 10, 64)
+	if nil != err {
+		return 0, x.fail(token, "Invalid integer "+token.String())
+	}
+	return result, nil
+}
+
+func (x *RuleParser) samples() (Samples, error) {
+	var result Samples
+	for {
+		if x.accept(tokenSymbol, "\u2026") {
+			result.infinite = true
+			return result, nil
+		}
+
+		token := x.next()
+		if tokenNumber != token.kind {
+			return result, x.fail(token, "Expected a sample value but got "+token.String())
+		}
+
+		sample := SampleRange{token.text, token.text}
+		if x.accept(tokenSymbol, "~") {
+			token = x.next()
+			if tokenNumber != token.kind {
+				return result, x.fail(token, "Expected a sample value but got "+token.String())
+			}
+			sample.to = token.text
+		}
+		result.ranges = append(result.ranges, sample)
+
+		if !x.accept(tokenSymbol, ",") {
+			// CLDR sometimes omits the comma before the ellipsis
+			if x.accept(tokenSymbol, "\u2026") {
+				result.infinite = true
+			}
+			return result, nil
+		}
+	}
+}
+
+func (x Relation) String() string {
+	result := string(x.operand)
+	if 0 != x.modulus {
+		result += fmt.Sprintf(" %% %d", x.modulus)
+	}
+
+	switch {
+	case x.within && x.negated:
+		result += " not within "
+	case x.within:
+		result += " within "
+	case x.negated:
+		result += " != "
+	default:
+		result += " = "
+	}
+
+	for idx, r := range x.ranges {
+		if idx > 0 {
+			result += ","
+		}
+		result += strconv.FormatInt(r.from, 10)
+		if r.to != r.from {
+			result += ".." + strconv.FormatInt(r.to, 10)
+		}
+	}
+	return result
+}
+
+func (x Condition) String() string {
 	var result []string
+	for _, relations := range x {
+		var buffer []string
+		for _, relation := range relations {
+			buffer = append(buffer, relation.String())
+		}
+		result = append(result, strings.Join(buffer, " and "))
+	}
+	return strings.Join(result, " or ")
+}
+
+// Returns the rules of a culture in the canonical syntax, without samples
+func canonicalRules(rules map[string]Rule) string {
+	if nil == rules {
+		return "-"
+	}
+
+	var result []string
+	for _, key := range []string{"zero", "one", "two", "few", "many", "other"} {
+		if rule, ok := rules[key]; ok {
+			result = append(result, key+": "+rule.condition.String())
+		}
+	}
+	return strings.Join(result, "; ")
+}
+
+func sample2operands(sample string) (Operands, error) {
+	var result Operands
+
+	mantissa := sample
+	if pos := strings.IndexAny(sample, "ce"); -1 != pos {
+		mantissa = sample[:pos]
+
+		exponent, err := strconv.ParseInt(sample[pos+1:], 10, 64)
+		if nil != err {
+			return result, err
+		}
+		result.e = exponent
+	}
+
+	integer, fraction := mantissa, ""
+	if pos := strings.Index(mantissa, "."); -1 != pos {
+		integer, fraction = mantissa[:pos], mantissa[pos+1:]
+	}
+
+	// 1.2c3 is 1200
+	if int(result.e) >= len(fraction) {
+		integer += fraction + strings.Repeat("0", int(result.e)-len(fraction))
+		fraction = ""
+	} else {
+		integer += fraction[:result.e]
+		fraction = fraction[result.e:]
+	}
+
+	var err error
+	result.i, err = strconv.ParseInt(integer, 10, 64)
+	if nil != err {
+		return result, err
+	}
+
+	if "" != fraction {
+		result.v = int64(len(fraction))
+		result.f, err = strconv.ParseInt(fraction, 10, 64)
+		if nil != err {
+			return result, err
+		}
+
+		trimmed := strings.TrimRight(fraction, "0")
+		result.w = int64(len(trimmed))
+		result.t = result.f
+		for i := result.w; i < result.v; i++ {
+			result.t /= 10
+		}
+	}
+	return result, nil
+}
 
-	conditions := strings.Split(x.right, ",")
-	for _, condition := range conditions {
-		pos := strings.Index(condition, "..")
+func (x Relation) matches(operands Operands) bool {
+	var value int64
+	switch x.operand {
+	case 'n', 'i':
+		value = operands.i
+	case 'v':
+		value = operands.v
+	case 'w':
+		value = operands.w
+	case 'f':
+		value = operands.f
+	case 't':
+		value = operands.t
+	case 'e':
+		value = operands.e
+	}
 
-		if -1 != pos {
-			lower_bound, upper_bound := condition[:pos], condition[pos+2:]
-			lb, _ := strconv.Atoi(lower_bound)
-			ub, _ := strconv.Atoi(upper_bound)
+	if 0 != x.modulus {
+		value %= x.modulus
+	}
+
+	// `n` (hence `n % x`) has a fractional part when `t` is not zero
+	fractional := 'n' == x.operand && 0 != operands.t
 
-			r := rangeCondition(x.left, lb, ub, x.operator)
-			result = append(result, r...)
+	result := false
+	for _, r := range x.ranges {
+		if fractional {
+			result = x.within && value >= r.from && value < r.to
 		} else {
-			result = append(result, fmt.Sprintf("%s %s %s", x.left, x.operator, condition))
+			result = value >= r.from && value <= r.to
+		}
+
+		if result {
+			break
 		}
 	}
-	return result
+	return result != x.negated
 }
 
-func get(url, key string, headers *string) (map[string]map[string]string, error) {
-	fmt.Print("GET ", url)
+func (x Condition) matches(operands Operands) bool {
+	for _, relations := range x {
+		result := true
+		for _, relation := range relations {
+			if !relation.matches(operands) {
+				result = false
+				break
+			}
+		}
+
+		if result {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the category the generated code selects for a sample value
+func category(rules map[string]Rule, sample string) (string, error) {
+	operands, err := sample2operands(sample)
+	if nil != err {
+		return "", err
+	}
+
+	for _, key := range []string{"zero", "one", "two", "few", "many"} {
+		if rule, ok := rules[key]; ok && rule.condition.matches(operands) {
+			return key, nil
+		}
+	}
+	return "other", nil
+}
+
+func isURL(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+func fetch(source string) ([]byte, error) {
+	if !isURL(source) {
+		return ioutil.ReadFile(source)
+	}
 
-	response, err := http.Get(url)
+	response, err := http.Get(source)
 	if err != nil {
 		return nil, err
 	}
 	defer response.Body.Close()
 
 	if 200 != response.StatusCode {
-		return nil, fmt.Errorf(response.Status)
+		return nil, fmt.Errorf("%s", response.Status)
 	}
+	return ioutil.ReadAll(response.Body)
+}
 
-	contents, err := ioutil.ReadAll(response.Body)
+func parse(contents []byte, origin, key string, headers *string) (map[string]map[string]string, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(contents), []byte("<")) {
+		return parseXML(contents, origin, key, headers)
+	}
+	return parseJSON(contents, origin, key, headers)
+}
 
+func parseJSON(contents []byte, origin, key string, headers *string) (map[string]map[string]string, error) {
 	var document map[string]map[string]json.RawMessage
-	err = json.Unmarshal([]byte(contents), &document)
+	err := json.Unmarshal(contents, &document)
 	if nil != err {
 		return nil, err
 	}
@@ -143,7 +835,7 @@ func get(url, key string, headers *string) (map[string]map[string]string, error)
 	if _, ok := document["supplemental"]; !ok {
 		return nil, fmt.Errorf("Data does not appear to be CLDR data")
 	}
-	*headers += fmt.Sprintf("//\n// URL: %s\n", url)
+	*headers += fmt.Sprintf("//\n// %s\n", origin)
 
 	{
 		var version map[string]string
@@ -151,10 +843,15 @@ func get(url, key string, headers *string) (map[string]map[string]string, error)
 		if nil != err {
 			return nil, err
 		}
-		*headers += fmt.Sprintf("// %s\n", version["_number"])
+		if number, ok := version["_number"]; ok {
+			*headers += fmt.Sprintf("// %s\n", number)
+		} else {
+			// Recent releases only give the CLDR version, without any generation date
+			*headers += fmt.Sprintf("// CLDR %s\n", version["_cldrVersion"])
+		}
 	}
 
-	{
+	if _, ok := document["supplemental"]["generation"]; ok {
 		var generation map[string]string
 		err = json.Unmarshal(document["supplemental"]["generation"], &generation)
 		if nil != err {
@@ -163,251 +860,628 @@ func get(url, key string, headers *string) (map[string]map[string]string, error)
 		*headers += fmt.Sprintf("// %s\n", generation["_date"])
 	}
 
-	var data map[string]map[string]string
-	err = json.Unmarshal(document["supplemental"]["plurals-type-"+key], &data)
-	if nil != err {
-		return nil, err
+	if "aliases" == key {
+		return parseJSONAliases(document["supplemental"]["metadata"])
+	}
+
+	// parentLocales.json holds a single "parentLocale" map, pluralRanges.json
+	// a "pluralRange-start-one-end-other" map by culture
+	member := "plurals-type-" + key
+	if "parents" == key {
+		member = "parentLocales"
+	} else if "ranges" == key {
+		member = "plurals"
+	}
+
+	if 0 == len(document["supplemental"][member]) {
+		return nil, fmt.Errorf("No `%s` data found", member)
+	}
+
+	var data map[string]map[string]string
+	err = json.Unmarshal(document["supplemental"][member], &data)
+	if nil != err {
+		return nil, err
+	}
+	if nil == data {
+		return nil, fmt.Errorf("No `%s` data found", member)
+	}
+	return data, nil
+}
+
+// Same layout as parentLocales.json: {"languageAlias": {"iw": "he", ...}}
+func parseJSONAliases(contents json.RawMessage) (map[string]map[string]string, error) {
+	var metadata struct {
+		Alias struct {
+			LanguageAlias map[string]struct {
+				Replacement string `json:"_replacement"`
+			} `json:"languageAlias"`
+		} `json:"alias"`
+	}
+
+	if 0 == len(contents) {
+		return nil, fmt.Errorf("No `metadata` data found")
+	}
+	err := json.Unmarshal(contents, &metadata)
+	if nil != err {
+		return nil, err
+	}
+
+	aliases := make(map[string]string)
+	for code, alias := range metadata.Alias.LanguageAlias {
+		aliases[code] = alias.Replacement
+	}
+	if 0 == len(aliases) {
+		return nil, fmt.Errorf("No language aliases found")
+	}
+	return map[string]map[string]string{"languageAlias": aliases}, nil
+}
+
+func parseXML(contents []byte, origin, key string, headers *string) (map[string]map[string]string, error) {
+	var document XmlSupplementalData
+	err := xml.Unmarshal(contents, &document)
+	if nil != err {
+		return nil, err
+	}
+
+	if "parents" == key {
+		return parseXMLParents(document, origin, headers)
+	} else if "aliases" == key {
+		return parseXMLAliases(document, origin, headers)
+	} else if "ranges" == key {
+		return parseXMLRanges(document, origin, headers)
+	}
+
+	if 0 == len(document.Plurals) {
+		return nil, fmt.Errorf("Data does not appear to be CLDR data")
+	}
+	xmlHeaders(document, origin, headers)
+
+	var data map[string]map[string]string
+	for _, plurals := range document.Plurals {
+		if key != plurals.Type {
+			continue
+		}
+
+		data = make(map[string]map[string]string)
+		for _, rules := range plurals.Rules {
+			item := make(map[string]string)
+			for _, rule := range rules.Rules {
+				item["pluralRule-count-"+rule.Count] = strings.TrimSpace(rule.Rule)
+			}
+
+			// The JSON distribution uses "pt-PT" where LDML uses "pt_PT"
+			for _, culture := range strings.Fields(rules.Locales) {
+				data[strings.Replace(culture, "_", "-", -1)] = item
+			}
+		}
+	}
+
+	if nil == data {
+		return nil, fmt.Errorf("No `%s` plural rules found", key)
+	}
+	return data, nil
+}
+
+// Same layout as parentLocales.json: {"parentLocale": {"pt-AO": "pt-PT", ...}}
+func parseXMLParents(document XmlSupplementalData, origin string, headers *string) (map[string]map[string]string, error) {
+	parents := make(map[string]string)
+	for _, parent_locales := range document.ParentLocales {
+		// Parents specific to collations, segmentations...
+		if "" != parent_locales.Component {
+			continue
+		}
+
+		for _, item := range parent_locales.Parents {
+			for _, locale := range strings.Fields(item.Locales) {
+				parents[strings.Replace(locale, "_", "-", -1)] = strings.Replace(item.Parent, "_", "-", -1)
+			}
+		}
+	}
+
+	if 0 == len(parents) {
+		return nil, fmt.Errorf("No parent locales found")
+	}
+	xmlHeaders(document, origin, headers)
+	return map[string]map[string]string{"parentLocale": parents}, nil
+}
+
+// Same layout as aliases.json once parsed: {"languageAlias": {"iw": "he", ...}}
+func parseXMLAliases(document XmlSupplementalData, origin string, headers *string) (map[string]map[string]string, error) {
+	aliases := make(map[string]string)
+	for _, alias := range document.LanguageAliases {
+		aliases[alias.Type] = alias.Replacement
+	}
+
+	if 0 == len(aliases) {
+		return nil, fmt.Errorf("No language aliases found")
+	}
+	xmlHeaders(document, origin, headers)
+	return map[string]map[string]string{"languageAlias": aliases}, nil
+}
+
+// Same layout as pluralRanges.json: {"fr": {"pluralRange-start-one-end-other": "other", ...}, ...}
+func parseXMLRanges(document XmlSupplementalData, origin string, headers *string) (map[string]map[string]string, error) {
+	data := make(map[string]map[string]string)
+	for _, plurals := range document.Plurals {
+		for _, ranges := range plurals.Ranges {
+			item := make(map[string]string)
+			for _, plural_range := range ranges.Ranges {
+				item["pluralRange-start-"+plural_range.Start+"-end-"+plural_range.End] = plural_range.Result
+			}
+
+			for _, culture := range strings.Fields(ranges.Locales) {
+				data[strings.Replace(culture, "_", "-", -1)] = item
+			}
+		}
+	}
+
+	if 0 == len(data) {
+		return nil, fmt.Errorf("No plural ranges found")
+	}
+	xmlHeaders(document, origin, headers)
+	return data, nil
+}
+
+func xmlHeaders(document XmlSupplementalData, origin string, headers *string) {
+	*headers += fmt.Sprintf("//\n// %s\n", origin)
+	*headers += fmt.Sprintf("// %s\n", document.Version.Number)
+	if "" != document.Generation.Date {
+		*headers += fmt.Sprintf("// %s\n", document.Generation.Date)
+	}
+}
+
+func get(source, key string, headers *string) (map[string]map[string]string, error) {
+	fmt.Print("GET ", source)
+
+	contents, err := fetch(source)
+	if nil != err {
+		return nil, err
+	}
+
+	origin := "URL: " + source
+	if !isURL(source) {
+		origin = "File: " + filepath.ToSlash(source)
+	}
+	return parse(contents, origin, key, headers)
+}
+
+// Returns the supplemental file `filename` of a cldr-core release archive,
+// whether it uses the "unicode-cldr/cldr-core" or the "cldr-json/cldr-core" layout
+func findInArchive(archive *zip.Reader, filename string) *zip.File {
+	var result *zip.File
+	for _, file := range archive.File {
+		name := strings.TrimPrefix(file.Name, "/")
+
+		if strings.HasSuffix(name, "cldr-core/supplemental/"+filename) {
+			return file
+		} else if nil == result && ("supplemental/"+filename == name || strings.HasSuffix(name, "/supplemental/"+filename)) {
+			result = file
+		}
+	}
+	return result
+}
+
+func getFromArchive(archive *zip.Reader, archive_name, name, key string, headers *string) (map[string]map[string]string, error) {
+	fmt.Print("OPEN ", archive_name)
+
+	file := findInArchive(archive, name+".json")
+	if nil == file {
+		file = findInArchive(archive, name+".xml")
+	}
+
+	if nil == file {
+		return nil, fmt.Errorf("Neither `%s.json` nor `%s.xml` found in archive", name, name)
+	}
+	fmt.Print(" ", file.Name)
+
+	reader, err := file.Open()
+	if nil != err {
+		return nil, err
+	}
+	defer reader.Close()
+
+	contents, err := ioutil.ReadAll(reader)
+	if nil != err {
+		return nil, err
+	}
+	return parse(contents, fmt.Sprintf("Archive: %s\n// Path: %s", archive_name, file.Name), key, headers)
+}
+
+// Returns the path of a supplemental file within an unpacked cldr-core tree
+// (`dir` being either the root of the tree or its "supplemental" directory)
+// or within the XML distribution of CLDR
+func cldrPath(dir, name string) string {
+	candidates := []string{
+		filepath.Join(dir, "supplemental", name+".json"),
+		filepath.Join(dir, name+".json"),
+		filepath.Join(dir, "common", "supplemental", name+".xml"),
+		filepath.Join(dir, "supplemental", name+".xml"),
+		filepath.Join(dir, name+".xml"),
+	}
+
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); nil == err {
+			return candidate
+		}
+	}
+	return candidates[0]
+}
+
+// Returns the cheapest conditions checking that `varname` is (or is not) in
+// the range: equalities for one or two values, comparisons otherwise
+func rangeCondition(varname string, r Range, negated bool, integer string) []string {
+	var result []string
+
+	// Unlike `in`, `n within` also matches the decimal values of the range,
+	// which are checked against the integer digits of `n`
+	if "" != integer && r.from != r.to {
+		condition := fmt.Sprintf("within(%s, %s, %d, %d)", varname, integer, r.from, r.to)
+		if negated {
+			condition = "!" + condition
+		}
+		return append(result, condition)
+	}
+
+	if r.to-r.from < 2 {
+		operator := "=="
+		if negated {
+			operator = "!="
+		}
+
+		for i := r.from; i <= r.to; i++ {
+			result = append(result, fmt.Sprintf("%s %s %d", varname, operator, i))
+		}
+		return result
+	}
+
+	// Operands are unsigned and `n` never lies in a range when it has a fraction
+	if negated {
+		if 0 == r.from {
+			return append(result, fmt.Sprintf("%s > %d", varname, r.to))
+		}
+		return append(result, fmt.Sprintf("(%s < %d || %s > %d)", varname, r.from, varname, r.to))
+	}
+
+	if 0 == r.from {
+		return append(result, fmt.Sprintf("%s <= %d", varname, r.to))
+	}
+	return append(result, fmt.Sprintf("%s >= %d && %s <= %d", varname, r.from, varname, r.to))
+}
+
+func relation2code(relation Relation, ptr_vars *[]string) []string {
+	varname := toVar(relation.operand, relation.modulus, ptr_vars)
+
+	integer := ""
+	if relation.within && 'n' == relation.operand {
+		integer = toVar('i', relation.modulus, ptr_vars)
+	}
+
+	var result []string
+	for _, r := range relation.ranges {
+		result = append(result, rangeCondition(varname, r, relation.negated, integer)...)
+	}
+	return result
+}
+
+func condition2code(condition Condition, ptr_vars *[]string) []string {
+	var result []string
+	for _, relations := range condition {
+		if 1 == len(relations) && !relations[0].negated {
+			result = append(result, relation2code(relations[0], ptr_vars)...)
+			continue
+		}
+
+		var buffer []string
+		for _, relation := range relations {
+			conditions := relation2code(relation, ptr_vars)
+			if relation.negated {
+				buffer = append(buffer, strings.Join(conditions, " && "))
+			} else {
+				buffer = append(buffer, joinOr(conditions))
+			}
+		}
+		result = append(result, strings.Join(buffer, " && "))
+	}
+	return result
+}
+
+func joinOr(data []string) string {
+	if len(data) > 1 {
+		return "(" + strings.Join(data, " || ") + ")"
+	}
+	return data[0]
+}
+
+func rule2code(key string, rules map[string]Rule, ptr_vars *[]string, padding string) string {
+	if rule, ok := rules[key]; ok {
+		result := ""
+
+		if "other" == key {
+			if 1 == len(rules) {
+				return padding + "return Other\n"
+			}
+			result += padding + "default:\n"
+		} else {
+			cases := condition2code(rule.condition, ptr_vars)
+			result += "\n" + padding + "case " + strings.Join(cases, ", ") + ":\n"
+		}
+		result += padding + "\treturn " + categoryName(key) + "\n"
+		return result
+	}
+	return ""
+}
+
+// Name of the constant of a category in the generated package, eg. `Few`
+func categoryName(key string) string {
+	return strings.ToUpper(key[:1]) + key[1:]
+}
+
+func map2code(rules map[string]Rule, ptr_vars *[]string, padding string) string {
+	if 1 == len(rules) {
+		return rule2code("other", rules, ptr_vars, padding)
+	}
+	result := padding + "switch {\n"
+	result += rule2code("other", rules, ptr_vars, padding)
+	result += rule2code("zero", rules, ptr_vars, padding)
+	result += rule2code("one", rules, ptr_vars, padding)
+	result += rule2code("two", rules, ptr_vars, padding)
+	result += rule2code("few", rules, ptr_vars, padding)
+	result += rule2code("many", rules, ptr_vars, padding)
+	result += padding + "}\n"
+	return result
+}
+
+// Returns the values of a sample range, stepping by the precision of its
+// bounds (`0.0~1.5` being 0.0, 0.1, ..., 1.5), at most `limit` of them
+func expandSample(sample SampleRange, limit int) []string {
+	if sample.from == sample.to {
+		return []string{sample.from}
+	}
+
+	// Compact decimal values are not expanded
+	if -1 != strings.IndexAny(sample.from+sample.to, "ce") {
+		return []string{sample.from, sample.to}
+	}
+
+	scale := 0
+	for _, bound := range []string{sample.from, sample.to} {
+		if pos := strings.Index(bound, "."); -1 != pos && len(bound)-pos-1 > scale {
+			scale = len(bound) - pos - 1
+		}
+	}
+
+	scaled := func(bound string) int64 {
+		integer, fraction := bound, ""
+		if pos := strings.Index(bound, "."); -1 != pos {
+			integer, fraction = bound[:pos], bound[pos+1:]
+		}
+		result, _ := strconv.ParseInt(integer+fraction+strings.Repeat("0", scale-len(fraction)), 10, 64)
+		return result
+	}
+
+	from, to := scaled(sample.from), scaled(sample.to)
+	step := int64(1)
+	if limit > 1 && to-from+1 > int64(limit) {
+		step = (to - from + int64(limit) - 2) / int64(limit-1)
 	}
-	return data, nil
-}
 
-func rangeCondition(varname string, lower, upper int, operator string) []string {
 	var result []string
-	for i := lower; i <= upper; i++ {
-		result = append(result, fmt.Sprintf("%s %s %d", varname, operator, i))
+	for value := from; value <= to; value += step {
+		if value+step > to {
+			// Always includes the upper bound
+			value = to
+		}
+
+		str := strconv.FormatInt(value, 10)
+		if scale > 0 {
+			if len(str) <= scale {
+				str = strings.Repeat("0", scale-len(str)+1) + str
+			}
+			str = str[:len(str)-scale] + "." + str[len(str)-scale:]
+		}
+		result = append(result, str)
 	}
 	return result
 }
 
-func pattern2code(input string, ptr_vars *[]string) []string {
-	left, short, operator, logic := "", "", "", ""
+// Returns a few values, greater than the samples, of the same category
+// (this is what the trailing `…` of a sample list stands for)
+func extraSamples(expected string, rules map[string]Rule, values []string) []string {
+	var result []string
 
-	var ops []Op
-	buf := ""
-loop:
-	for _, char := range input {
-		switch char {
-		default:
-			buf += string(char)
+	top := int64(1)
+	for _, value := range values {
+		if operands, err := sample2operands(value); nil == err {
+			for top <= operands.i {
+				top *= 10
+			}
+		}
+	}
 
-		case '@':
-			break loop
+	for _, offset := range []int64{top, top * 10, top * 100} {
+		for _, value := range values {
+			if len(result) >= extra_samples {
+				return result
+			}
 
-		case ' ':
+			if -1 != strings.IndexAny(value, "ce") {
+				continue
+			}
 
-		case '=':
-			if "" != buf {
-				left, operator, buf = buf, "==", ""
-				short = toVar(left, ptr_vars)
+			integer, fraction := value, ""
+			if pos := strings.Index(value, "."); -1 != pos {
+				integer, fraction = value[:pos], value[pos:]
 			}
 
-		case '!':
-			left, operator, buf = buf, "!=", ""
-			short = toVar(left, ptr_vars)
-		}
+			i, err := strconv.ParseInt(integer, 10, 64)
+			if nil != err {
+				continue
+			}
 
-		if "" != buf {
-			pos := strings.Index(buf, "and")
+			candidate := strconv.FormatInt(i+offset, 10) + fraction
+			if key, err := category(rules, candidate); nil == err && expected == key {
+				result = append(result, candidate)
+			}
+		}
+	}
+	return result
+}
 
-			if -1 != pos {
-				ops = append(ops, Op{logic, short, operator, buf[:pos], "AND"})
-				buf, left, operator, logic = "", "", "", "AND"
-			} else {
-				pos = strings.Index(buf, "or")
+func samples2test(expected string, rules map[string]Rule, samples Samples, ordinal, decimal bool) []Test {
+	var values []string
 
-				if -1 != pos {
-					ops = append(ops, Op{logic, short, operator, buf[:pos], "OR"})
-					buf, left, operator, logic = "", "", "", "OR"
-				}
+	seen := make(map[string]bool)
+	for _, sample := range samples.ranges {
+		for _, value := range expandSample(sample, *user_sample_limit) {
+			if !seen[value] {
+				seen[value] = true
+				values = append(values, value)
 			}
 		}
 	}
 
-	if "" != buf {
-		ops = append(ops, Op{logic, short, operator, buf, ""})
+	if samples.infinite {
+		values = append(values, extraSamples(expected, rules, values)...)
 	}
 
-	if 1 == len(ops) {
-		conditions := ops[0].conditions()
-		if "==" == ops[0].operator {
-			return conditions
-		} else {
-			return []string{strings.Join(conditions, " && ")}
+	var result []Test
+	for _, value := range values {
+		if decimal || -1 != strings.IndexAny(value, "ce") {
+			value = "\"" + value + "\""
 		}
+		result = append(result, UnitTest{ordinal, expected, value})
 	}
+	return result
+}
 
-	var result []string
-	var buffer []string
+func rule2test(expected string, rules map[string]Rule, ordinal bool) []Test {
+	rule := rules[expected]
+	result := samples2test(expected, rules, rule.integers, ordinal, false)
+	return append(result, samples2test(expected, rules, rule.decimals, ordinal, true)...)
+}
 
-	buffer_length := 0
-	for _, o := range ops {
-		conditions := o.conditions()
-		logic = o.previous_logic
-		nextLogic := o.next_logic
-		operator := o.operator
+func map2test(ordinals, plurals map[string]Rule) []Test {
+	var result []Test
 
-		if "OR" == logic && buffer_length > 0 {
-			result = append(result, strings.Join(buffer, ", "))
-			buffer = []string{}
-			buffer_length = 0
+	for _, key := range []string{"one", "two", "few", "many", "zero", "other"} {
+		if _, ok := ordinals[key]; ok {
+			result = append(result, rule2test(key, ordinals, true)...)
 		}
 
-		if ("" == logic && "OR" == nextLogic) || ("OR" == logic && "OR" == nextLogic) || ("OR" == logic && "" == nextLogic) {
-			if "==" == operator {
-				buffer = append(buffer, conditions...)
-			} else {
-				buffer = append(buffer, strings.Join(conditions, " && "))
-			}
-			buffer_length = len(buffer)
-		} else if "AND" == logic && ("AND" == nextLogic || "" == nextLogic) {
-			if "==" == operator {
-				buffer[buffer_length-1] += " && " + joinOr(conditions)
-			} else {
-				buffer[buffer_length-1] += " && " + strings.Join(conditions, " && ")
-			}
-		} else if "" == logic && "AND" == nextLogic {
-			if "==" == operator {
-				buffer = append(buffer, joinOr(conditions))
-			} else {
-				buffer = append(buffer, strings.Join(conditions, " && "))
-			}
-			buffer_length = len(buffer)
-		} else if "OR" == logic && "AND" == nextLogic {
-			if "==" == operator {
-				if len(conditions) > 1 {
-					buffer = append(buffer, joinOr(conditions))
-				} else {
-					buffer = append(buffer, conditions...)
-				}
-			} else {
-				buffer = append(buffer, strings.Join(conditions, " && "))
-			}
-			buffer_length = len(buffer)
-		} else if "AND" == logic && "OR" == nextLogic {
-			if "==" == operator {
-				buffer[buffer_length-1] += " && " + joinOr(conditions)
-			} else {
-				buffer[buffer_length-1] += " && " + strings.Join(conditions, " && ")
-			}
+		if _, ok := plurals[key]; ok {
+			result = append(result, rule2test(key, plurals, false)...)
 		}
 	}
+	return result
+}
 
-	if len(buffer) > 0 {
-		if "OR" == logic {
-			result = append(result, buffer...)
-		} else {
-			result = append(result, strings.Join(buffer, " && "))
+// Returns the sample values of a rule as Go literals, integers first
+func sampleLiterals(rule Rule) []string {
+	var result []string
+	for _, sample := range rule.integers.ranges {
+		for _, value := range expandSample(sample, *user_sample_limit) {
+			if -1 != strings.IndexAny(value, "ce") {
+				value = "\"" + value + "\""
+			}
+			result = append(result, value)
+		}
+	}
+	for _, sample := range rule.decimals.ranges {
+		for _, value := range expandSample(sample, *user_sample_limit) {
+			result = append(result, "\""+value+"\"")
 		}
 	}
 	return result
 }
 
-func joinOr(data []string) string {
-	if len(data) > 1 {
-		return "(" + strings.Join(data, " || ") + ")"
-	}
-	return data[0]
+// Approximate value of a sample literal, eg. "\"1.2c3\"" => 1200
+func sampleValue(literal string) float64 {
+	result, _ := strconv.ParseFloat(strings.Replace(strings.Trim(literal, "\""), "c", "e", 1), 64)
+	return result
 }
 
-func rule2code(key string, data map[string]string, ptr_vars *[]string, padding string) string {
-	if input, ok := data["pluralRule-count-"+key]; ok {
-		result := ""
+// Returns a test for each range of a culture, from a sample of its start
+// category to the first greater sample of its end category, if any
+func ranges2test(culture string, ranges []PluralRange, rules map[string]Rule) []Test {
+	var result []Test
+	for _, item := range ranges {
+		starts, ends := sampleLiterals(rules[item.start]), sampleLiterals(rules[item.end])
+		if 0 == len(starts) || 0 == len(ends) {
+			continue
+		}
 
-		if "other" == key {
-			if 1 == len(data) {
-				return padding + "return \"other\"\n"
+		end := ends[0]
+		for _, value := range ends {
+			if sampleValue(value) > sampleValue(starts[0]) {
+				end = value
+				break
 			}
-			result += padding + "default:\n"
-		} else {
-			cases := pattern2code(input, ptr_vars)
-			result += "\n" + padding + "case " + strings.Join(cases, ", ") + ":\n"
 		}
-		result += padding + "\treturn \"" + key + "\"\n"
-		return result
+		result = append(result, RangeTest{culture, item.result, starts[0], end})
 	}
-	return ""
-}
-
-func map2code(data map[string]string, ptr_vars *[]string, padding string) string {
-	if 1 == len(data) {
-		return rule2code("other", data, ptr_vars, padding)
-	}
-	result := padding + "switch {\n"
-	result += rule2code("other", data, ptr_vars, padding)
-	result += rule2code("zero", data, ptr_vars, padding)
-	result += rule2code("one", data, ptr_vars, padding)
-	result += rule2code("two", data, ptr_vars, padding)
-	result += rule2code("few", data, ptr_vars, padding)
-	result += rule2code("many", data, ptr_vars, padding)
-	result += padding + "}\n"
 	return result
 }
 
-func splitValues(input string) []string {
+// Returns a diagnostic for each sample value that the rules do not put in the
+// category it illustrates
+func checkSamples(rules map[string]Rule, kind string) []string {
 	var result []string
 
-	pos := -1
-	for idx, char := range input {
-		switch {
-		case (char >= '0' && char <= '9') || '.' == char:
-			if -1 == pos {
-				pos = idx
-			}
+	for _, key := range []string{"zero", "one", "two", "few", "many", "other"} {
+		rule, ok := rules[key]
+		if !ok {
+			continue
+		}
 
-		// Inutile de générer un interval lorsque l'on rencontre '~' :)
-		case ' ' == char || ',' == char || '~' == char:
-			if -1 != pos {
-				result = append(result, input[pos:idx])
-				pos = -1
+		for _, samples := range []Samples{rule.integers, rule.decimals} {
+			for _, sample := range samples.ranges {
+				for _, value := range expandSample(sample, 0) {
+					actual, err := category(rules, value)
+					if nil != err {
+						result = append(result, fmt.Sprintf("%s sample `%s` of `%s`: %s", kind, value, key, err))
+					} else if key != actual {
+						result = append(result, fmt.Sprintf("%s sample `%s`: expected `%s` but got `%s`", kind, value, key, actual))
+					}
+				}
 			}
 		}
 	}
-
-	if -1 != pos {
-		result = append(result, input[pos:])
-	}
 	return result
 }
 
-func pattern2test(expected, input string, ordinal bool) []Test {
-	var result []Test
-
-	patterns := strings.Split(input, "@")
-	for _, pattern := range patterns {
-		if strings.HasPrefix(pattern, "integer") {
-			for _, value := range splitValues(pattern[8:]) {
-				result = append(result, UnitTest{ordinal, expected, value})
-			}
-		} else if strings.HasPrefix(pattern, "decimal") {
-			for _, value := range splitValues(pattern[8:]) {
-				result = append(result, UnitTest{ordinal, expected, "\"" + value + "\""})
-			}
-		}
+// Parses the rules of a culture, keyed by their category
+func parseRules(data map[string]string) (map[string]Rule, error) {
+	if nil == data {
+		return nil, nil
 	}
-	return result
-}
 
-func map2test(ordinals, plurals map[string]string) []Test {
-	var result []Test
+	result := make(map[string]Rule)
+	for key, input := range data {
+		key = strings.TrimPrefix(key, "pluralRule-count-")
 
-	for _, rule := range []string{"one", "two", "few", "many", "zero", "other"} {
-		if input, ok := ordinals["pluralRule-count-"+rule]; ok {
-			result = append(result, pattern2test(rule, input, true)...)
+		rule, err := parseRule(input)
+		if nil != err {
+			return nil, fmt.Errorf("`%s`: %s", key, err)
 		}
 
-		if input, ok := plurals["pluralRule-count-"+rule]; ok {
-			result = append(result, pattern2test(rule, input, false)...)
+		if "other" == key && nil != rule.condition {
+			return nil, fmt.Errorf("`other`: Unexpected condition in `%s`", input)
 		}
+		result[key] = rule
 	}
-	return result
+	return result, nil
 }
 
-func culture2code(ordinals, plurals map[string]string, padding string) (string, string, []Test) {
+func culture2code(ordinals, plurals map[string]Rule, padding string) (string, string, []Test) {
 	var code string
 	var vars []string
 
 	if nil == ordinals {
-		code = map2code(plurals, &vars, padding)
+		// Rather than the cardinal category of the value, see NoOrdinal
+		code = padding + "if ordinal {\n"
+		code += padding + "\treturn NoOrdinal\n"
+		code += padding + "}\n\n"
+		code += map2code(plurals, &vars, padding)
 	} else {
 		code = padding + "if ordinal {\n"
 		code += map2code(ordinals, &vars, padding+"\t")
@@ -429,28 +1503,15 @@ func culture2code(ordinals, plurals map[string]string, padding string) (string,
 		// w	    number of visible fraction digits in n, without trailing zeros.
 		// f	    visible fractional digits in n, with trailing zeros.
 		// t	    visible fractional digits in n, without trailing zeros.
-		var_f := varname('f', vars)
-		var_i := varname('i', vars)
-		var_n := varname('n', vars)
-		var_v := varname('v', vars)
-		var_t := varname('t', vars)
-		var_w := varname('w', vars)
-
-		if "_" != var_f || "_" != var_v || "_" != var_t || "_" != var_w {
-			str_vars += padding + fmt.Sprintf("%s, %s, %s, %s, %s, %s := finvtw(value)\n", var_f, var_i, var_n, var_v, var_t, var_w)
-		} else {
-			if "_" != var_n {
-				if "_" != var_i {
-					str_vars += padding + "flt := float(value)\n"
-					str_vars += padding + "n := math.Abs(flt)\n"
-					str_vars += padding + "i := int64(flt)\n"
-				} else {
-					str_vars += padding + "n := math.Abs(float(value))\n"
-				}
-			} else if "_" != var_i {
-				str_vars += padding + "i := int64(float(value))\n"
+		// e	    exponent of the power of 10 used in compact decimal formatting (`c` is a synonym).
+		var names, fields []string
+		for _, operand := range "nivwfte" {
+			if "_" != varname(byte(operand), vars) {
+				names = append(names, string(operand))
+				fields = append(fields, operandField(byte(operand)))
 			}
 		}
+		str_vars += padding + strings.Join(names, ", ") + " := " + strings.Join(fields, ", ") + "\n"
 
 		for i := 0; i < max; i += 2 {
 			k := vars[i]
@@ -464,6 +1525,15 @@ func culture2code(ordinals, plurals map[string]string, padding string) (string,
 	return str_vars, code, tests
 }
 
+// Expression of an operand in the generated functions, given their `ops Operands` argument
+func operandField(operand byte) string {
+	if 'n' == operand {
+		// Exact `n`, see the `Operands.n` method
+		return "ops.n()"
+	}
+	return "ops." + strings.ToUpper(string(operand))
+}
+
 func addVar(varname, expr string, ptr_vars *[]string) string {
 	exists := false
 	for i := 0; i < len(*ptr_vars); i += 2 {
@@ -479,19 +1549,17 @@ func addVar(varname, expr string, ptr_vars *[]string) string {
 	return varname
 }
 
-func toVar(expr string, ptr_vars *[]string) string {
-	var varname string
+func toVar(operand byte, modulus int64, ptr_vars *[]string) string {
+	varname := string(operand)
+	expr := varname
 
-	if pos := strings.Index(expr, "%"); -1 != pos {
-		k, v := expr[:pos], expr[pos+1:]
-		varname = k + v
-		if "n" == k {
-			expr = "mod(n, " + v + ")"
+	if 0 != modulus {
+		varname += strconv.FormatInt(modulus, 10)
+		if 'n' == operand {
+			expr = fmt.Sprintf("mod(n, %d)", modulus)
 		} else {
-			expr = k + " % " + v
+			expr = fmt.Sprintf("%c %% %d", operand, modulus)
 		}
-	} else {
-		varname = expr
 	}
 	return addVar(varname, expr, ptr_vars)
 }
@@ -505,7 +1573,7 @@ func varname(char uint8, vars []string) string {
 	return "_"
 }
 
-func createGoFiles(headers string, ptr_plurals, ptr_ordinals *map[string]map[string]string) error {
+func selectCultures(ptr_plurals *map[string]map[string]string) ([]string, error) {
 	var cultures []string
 	if "*" == *user_culture {
 		// On sait que len(ordinals) <= len(plurals)
@@ -517,7 +1585,7 @@ func createGoFiles(headers string, ptr_plurals, ptr_ordinals *map[string]map[str
 			culture = strings.TrimSpace(culture)
 
 			if _, ok := (*ptr_plurals)[culture]; !ok {
-				return fmt.Errorf("Aborted, `%s` not found...", culture)
+				return nil, fmt.Errorf("Aborted, `%s` not found...", culture)
 			}
 			cultures = append(cultures, culture)
 		}
@@ -525,12 +1593,24 @@ func createGoFiles(headers string, ptr_plurals, ptr_ordinals *map[string]map[str
 	sort.Strings(cultures)
 
 	if 0 == len(cultures) {
-		return fmt.Errorf("Not enough data to create source...")
+		return nil, fmt.Errorf("Not enough data to create source...")
+	}
+	return cultures, nil
+}
+
+func createGoFiles(headers string, ptr_plurals, ptr_ordinals *map[string]map[string]string, locales LocaleData) error {
+	cultures, err := selectCultures(ptr_plurals)
+	if nil != err {
+		return err
 	}
 
-	var items []Source
+	var items []FuncSource
 	var tests []Source
 
+	// Index in `items` of the function implementing each canonical rule set
+	funcs := make(map[string]int)
+
+	failures := 0
 	for _, culture := range cultures {
 		fmt.Print(culture)
 
@@ -549,27 +1629,252 @@ func createGoFiles(headers string, ptr_plurals, ptr_ordinals *map[string]map[str
 				}
 			}
 
-			vars, code, unit_tests := culture2code(ordinals, plurals, "\t\t")
-			items = append(items, FuncSource{culture, vars, code})
+			plural_rules, err := parseRules(plurals)
+			if nil != err {
+				fmt.Println(" \u2717")
+				return fmt.Errorf("Aborted, invalid plural rule for `%s` %s", culture, err)
+			}
+
+			ordinal_rules, err := parseRules(ordinals)
+			if nil != err {
+				fmt.Println(" \u2717")
+				return fmt.Errorf("Aborted, invalid ordinal rule for `%s` %s", culture, err)
+			}
+
+			diagnostics := append(checkSamples(ordinal_rules, "ordinal"), checkSamples(plural_rules, "cardinal")...)
+			if len(diagnostics) > 0 {
+				fmt.Println(" \u2717 - Samples disagree with the rules")
+				for _, diagnostic := range diagnostics {
+					fmt.Println("\t" + diagnostic)
+				}
+				failures++
+				continue
+			}
+
+			vars, code, unit_tests := culture2code(ordinal_rules, plural_rules, "\t")
+			unit_tests = append(unit_tests, ranges2test(culture, locales.Ranges[culture], plural_rules)...)
+
+			key := canonicalRules(ordinal_rules) + " | " + canonicalRules(plural_rules)
+			if idx, ok := funcs[key]; ok {
+				items[idx].cultures = append(items[idx].cultures, culture)
+			} else {
+				funcs[key] = len(items)
+				items = append(items, FuncSource{culture, vars, code, []string{culture}, nil != ordinal_rules})
+			}
 
 			fmt.Println(" \u2713")
 
 			if len(unit_tests) > 0 {
-				tests = append(tests, UnitTestSource{culture, unit_tests})
+				tests = append(tests, UnitTestSource{culture, unit_tests, plurals, ordinals})
 			}
 		}
 	}
 
+	if failures > 0 {
+		return fmt.Errorf("Aborted, the samples of %d culture(s) disagree with their rules", failures)
+	}
+
 	if len(tests) > 0 {
-		err := createSource("plural_test.tmpl", "plural/func_test.go", headers, tests)
+		samples, _ := analysisDomain()
+		err := createSource("plural_test.tmpl", "plural/func_test.go", headers, tests, locales, domain2code(samples, "\t"))
 		if nil != err {
 			return err
 		}
 	}
-	return createSource("plural.tmpl", "plural/func.go", headers, items)
+	var sources []Source
+	for _, item := range items {
+		sources = append(sources, item)
+	}
+	return createSource("plural.tmpl", "plural/func.go", headers, sources, locales, "")
+}
+
+// Values checked by the analysis: integers, decimals with up to 3 visible
+// fraction digits and numbers in compact decimal notation. They are also
+// written to plural/func_test.go, where the compiled rules are checked over
+// them (see domain2code).
+func analysisDomain() ([]string, []Operands) {
+	var samples []string
+
+	for i := 0; i <= 1200; i++ {
+		samples = append(samples, strconv.Itoa(i))
+	}
+	for _, power := range []int{10000, 100000, 1000000, 10000000} {
+		for i := 0; i <= 120; i++ {
+			samples = append(samples, strconv.Itoa(power+i), strconv.Itoa(2*power+i))
+		}
+	}
+
+	for i := 0; i <= 120; i++ {
+		for f := 0; f < 100; f++ {
+			samples = append(samples, fmt.Sprintf("%d.%02d", i, f))
+			if 0 == f%10 {
+				samples = append(samples, fmt.Sprintf("%d.%d", i, f/10))
+			}
+		}
+	}
+	for i := 0; i <= 2; i++ {
+		for f := 0; f < 1000; f++ {
+			samples = append(samples, fmt.Sprintf("%d.%03d", i, f))
+		}
+	}
+
+	for e := 1; e <= 9; e++ {
+		for _, mantissa := range []string{"1", "2", "5", "10", "11", "21", "100", "1.5", "1.0000001"} {
+			samples = append(samples, fmt.Sprintf("%sc%d", mantissa, e))
+		}
+	}
+
+	var domain []Operands
+	var result []string
+	for _, sample := range samples {
+		if operands, err := sample2operands(sample); nil == err {
+			result = append(result, sample)
+			domain = append(domain, operands)
+		}
+	}
+	return result, domain
+}
+
+// Lists the values checked by the analysis as Go strings, several on each line
+func domain2code(samples []string, padding string) string {
+	var result []string
+	for idx := 0; idx < len(samples); idx += 16 {
+		end := idx + 16
+		if end > len(samples) {
+			end = len(samples)
+		}
+
+		var line []string
+		for _, sample := range samples[idx:end] {
+			line = append(line, strconv.Quote(sample))
+		}
+		result = append(result, padding+strings.Join(line, ", ")+",")
+	}
+	return strings.Join(result, "\n")
+}
+
+// Reports the categories which overlap, which can never be selected, and
+// whether the category depends on the order of the generated cases
+func analyzeRules(rules map[string]Rule, samples []string, domain []Operands) []string {
+	var keys []string
+	for _, key := range []string{"zero", "one", "two", "few", "many"} {
+		if _, ok := rules[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+
+	type Overlap struct {
+		count   int
+		example string
+	}
+
+	selected := make(map[string]int)
+	matched := make(map[string]int)
+	overlaps := make(map[string]*Overlap)
+	var overlapping []string
+
+	for idx, operands := range domain {
+		var matching []string
+		for _, key := range keys {
+			if rules[key].condition.matches(operands) {
+				matching = append(matching, key)
+				matched[key]++
+			}
+		}
+
+		if 0 == len(matching) {
+			selected["other"]++
+			continue
+		}
+		selected[matching[0]]++
+
+		for i := 0; i < len(matching); i++ {
+			for j := i + 1; j < len(matching); j++ {
+				pair := "`" + matching[i] + "` and `" + matching[j] + "`"
+				if overlap, ok := overlaps[pair]; ok {
+					overlap.count++
+				} else {
+					overlaps[pair] = &Overlap{1, samples[idx]}
+					overlapping = append(overlapping, pair)
+				}
+			}
+		}
+	}
+
+	var result []string
+	for _, pair := range overlapping {
+		overlap := overlaps[pair]
+		result = append(result, fmt.Sprintf("%s overlap on %d value(s), eg. %s", pair, overlap.count, overlap.example))
+	}
+
+	for _, key := range append(keys, "other") {
+		if 0 != selected[key] {
+			continue
+		}
+
+		if "other" != key && 0 == matched[key] {
+			result = append(result, fmt.Sprintf("`%s` never matches", key))
+		} else {
+			result = append(result, fmt.Sprintf("`%s` is unreachable, always shadowed by a previous case", key))
+		}
+	}
+
+	if len(overlapping) > 0 {
+		result = append(result, "results depend on the order of the cases ("+strings.Join(keys, ", ")+")")
+	}
+	return result
+}
+
+func analyze(ptr_plurals, ptr_ordinals *map[string]map[string]string) error {
+	cultures, err := selectCultures(ptr_plurals)
+	if nil != err {
+		return err
+	}
+
+	samples, domain := analysisDomain()
+
+	failures := 0
+	for _, culture := range cultures {
+		fmt.Print(culture)
+
+		var diagnostics []string
+		for _, kind := range []string{"cardinal", "ordinal"} {
+			data := (*ptr_plurals)[culture]
+			if "ordinal" == kind {
+				data = (*ptr_ordinals)[culture]
+			}
+
+			rules, err := parseRules(data)
+			if nil != err {
+				diagnostics = append(diagnostics, fmt.Sprintf("%s %s", kind, err))
+				continue
+			}
+
+			if nil != rules {
+				for _, diagnostic := range analyzeRules(rules, samples, domain) {
+					diagnostics = append(diagnostics, kind+" "+diagnostic)
+				}
+			}
+		}
+
+		if 0 == len(diagnostics) {
+			fmt.Println(" \u2713")
+		} else {
+			fmt.Println(" \u2717")
+			for _, diagnostic := range diagnostics {
+				fmt.Println("\t" + diagnostic)
+			}
+			failures++
+		}
+	}
+
+	if failures > 0 {
+		return fmt.Errorf("The rules of %d culture(s) need attention", failures)
+	}
+	return nil
 }
 
-func createSource(tmpl_filepath, dest_filepath, headers string, items []Source) error {
+func createSource(tmpl_filepath, dest_filepath, headers string, items []Source, locales LocaleData, domain string) error {
 	source, err := template.ParseFiles(tmpl_filepath)
 	if nil != err {
 		return err
@@ -585,37 +1890,203 @@ func createSource(tmpl_filepath, dest_filepath, headers string, items []Source)
 		Headers   string
 		Timestamp string
 		Items     []Source
+		Locales   LocaleData
+		Domain    string
 	}{
 		headers,
 		time.Now().Format(time.RFC1123Z),
 		items,
+		locales,
+		domain,
 	})
 }
 
+// Number of tests generated for the trailing `…` of a sample list
+const extra_samples = 5
+
 var user_culture = flag.String("culture", "*", "Culture subset")
+var user_plurals = flag.String("plurals", "https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/plurals.json", "URL or local path of plurals.json (or plurals.xml)")
+var user_ordinals = flag.String("ordinals", "https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/ordinals.json", "URL or local path of ordinals.json (or ordinals.xml)")
+var user_parents = flag.String("parents", "https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/parentLocales.json", "URL or local path of parentLocales.json (or supplementalData.xml), optional")
+var user_aliases = flag.String("aliases", "https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/aliases.json", "URL or local path of aliases.json (or supplementalMetadata.xml), optional")
+var user_ranges = flag.String("ranges", "https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/pluralRanges.json", "URL or local path of pluralRanges.json (or pluralRanges.xml), optional")
+var user_cldr_dir = flag.String("cldr-dir", "", "Local cldr-core directory (overrides -plurals, -ordinals, -parents, -aliases and -ranges)")
+var user_cldr_zip = flag.String("cldr-zip", "", "Local cldr-core release archive (overrides -plurals, -ordinals, -parents, -aliases, -ranges and -cldr-dir)")
+var user_analyze = flag.Bool("analyze", false, "Report overlapping, unreachable and order-dependent categories instead of generating sources")
+var user_sample_limit = flag.Int("sample-limit", 20, "Maximum number of tests generated for a sample range, eg. `2~16`")
+
+// Returns the data of the first source available, eg. the explicit parents
+// of locales. Such data is optional: without it, the "plural" package
+// resolves fewer locales.
+func loadOptional(load func(string, string, *string) (map[string]map[string]string, error), sources []string, key string, headers *string) map[string]map[string]string {
+	for _, source := range sources {
+		var source_headers string
+		data, err := load(source, key, &source_headers)
+		if nil != err {
+			fmt.Println(" \u2717")
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(" \u2713")
+		*headers += source_headers
+		return data
+	}
+	return nil
+}
+
+// Reports locale data that could not be loaded, in the generated headers as
+// well, so that the package tells what it lacks
+func missingData(message string, headers *string) {
+	fmt.Println(message)
+	*headers += "//\n// " + message + "\n"
+}
+
+// Returns the locale data needed to resolve the requested locales: explicit
+// parents ("root" excepted, where the truncation of a tag ends anyway), the
+// aliases of the language codes whose replacement has rules, and the plural
+// ranges of the cultures having rules
+func loadLocales(load func(string, string, *string) (map[string]map[string]string, error), parents_sources, aliases_sources, ranges_sources []string, plurals map[string]map[string]string, headers *string) LocaleData {
+	var result LocaleData
+
+	parents := loadOptional(load, parents_sources, "parents", headers)["parentLocale"]
+	if nil == parents {
+		missingData("No parent locales, only falling back by truncation", headers)
+	} else {
+		result.Parents = make(map[string]string)
+		for locale, parent := range parents {
+			if "root" != parent {
+				result.Parents[locale] = parent
+			}
+		}
+	}
+
+	aliases := loadOptional(load, aliases_sources, "aliases", headers)["languageAlias"]
+	if nil == aliases {
+		missingData("No language aliases, deprecated codes are left as is", headers)
+	} else {
+		result.Aliases = make(map[string]string)
+		for code, replacement := range aliases {
+			// Keys such as "art_lojban", replacements such as "sr_Latn" or "sh_Latn sh_Cyrl"
+			code = strings.ToLower(strings.Replace(code, "_", "-", -1))
+			fields := strings.Fields(strings.Replace(replacement, "_", "-", -1))
+			if 0 == len(fields) {
+				continue
+			}
+
+			language := strings.SplitN(fields[0], "-", 2)[0]
+			if _, ok := plurals[language]; ok && code != fields[0] {
+				result.Aliases[code] = fields[0]
+			}
+		}
+	}
+
+	ranges := loadOptional(load, ranges_sources, "ranges", headers)
+	if nil == ranges {
+		missingData("No plural ranges, a range falls into the category of its end", headers)
+	} else {
+		result.Ranges = make(map[string][]PluralRange)
+
+		skipped := 0
+		for culture, data := range ranges {
+			rules, ok := plurals[culture]
+			if !ok {
+				continue
+			}
+
+			var items []PluralRange
+			for _, start := range []string{"zero", "one", "two", "few", "many", "other"} {
+				for _, end := range []string{"zero", "one", "two", "few", "many", "other"} {
+					category, ok := data["pluralRange-start-"+start+"-end-"+end]
+					if !ok {
+						continue
+					}
+
+					// Categories unknown to the rules, eg. taken from another CLDR release
+					for _, key := range []string{start, end, category} {
+						if _, ok := rules["pluralRule-count-"+key]; !ok {
+							category = ""
+						}
+					}
+
+					if "" == category {
+						skipped++
+					} else {
+						items = append(items, PluralRange{start, end, category})
+					}
+				}
+			}
+
+			if len(items) > 0 {
+				result.Ranges[culture] = items
+			}
+		}
+
+		if skipped > 0 {
+			fmt.Printf("%d plural range(s) skipped, their categories are not the ones of the rules\n", skipped)
+		}
+	}
+	return result
+}
 
 func main() {
 	flag.Parse()
 
+	plurals_source, ordinals_source := *user_plurals, *user_ordinals
+	parents_sources, aliases_sources, ranges_sources := []string{*user_parents}, []string{*user_aliases}, []string{*user_ranges}
+	if "" != *user_cldr_dir {
+		plurals_source = cldrPath(*user_cldr_dir, "plurals")
+		ordinals_source = cldrPath(*user_cldr_dir, "ordinals")
+		parents_sources = []string{cldrPath(*user_cldr_dir, "parentLocales"), cldrPath(*user_cldr_dir, "supplementalData")}
+		aliases_sources = []string{cldrPath(*user_cldr_dir, "aliases"), cldrPath(*user_cldr_dir, "supplementalMetadata")}
+		ranges_sources = []string{cldrPath(*user_cldr_dir, "pluralRanges")}
+	}
+
+	load := get
+	if "" != *user_cldr_zip {
+		archive, err := zip.OpenReader(*user_cldr_zip)
+		if nil != err {
+			fmt.Println(err, "(╯°□°）╯︵ ┻━┻")
+			os.Exit(1)
+		}
+		defer archive.Close()
+
+		plurals_source, ordinals_source = "plurals", "ordinals"
+		parents_sources = []string{"parentLocales", "supplementalData"}
+		aliases_sources = []string{"aliases", "supplementalMetadata"}
+		ranges_sources = []string{"pluralRanges"}
+		load = func(name, key string, headers *string) (map[string]map[string]string, error) {
+			return getFromArchive(&archive.Reader, filepath.Base(*user_cldr_zip), name, key, headers)
+		}
+	}
+
 	var headers string
 
-	ordinals, err := get("https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/ordinals.json", "ordinal", &headers)
+	ordinals, err := load(ordinals_source, "ordinal", &headers)
 	if nil != err {
 		fmt.Println(" \u2717")
 		fmt.Println(err)
+		os.Exit(1)
 	} else {
 		fmt.Println(" \u2713")
 
-		plurals, err := get("https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/plurals.json", "cardinal", &headers)
+		plurals, err := load(plurals_source, "cardinal", &headers)
 		if nil != err {
 			fmt.Println(" \u2717")
 			fmt.Println(err)
+			os.Exit(1)
 		} else {
 			fmt.Println(" \u2713")
 
-			err = createGoFiles(headers, &plurals, &ordinals)
+			if *user_analyze {
+				err = analyze(&plurals, &ordinals)
+			} else {
+				locales := loadLocales(load, parents_sources, aliases_sources, ranges_sources, plurals, &headers)
+				err = createGoFiles(headers, &plurals, &ordinals, locales)
+			}
+
 			if nil != err {
 				fmt.Println(err, "(╯°□°）╯︵ ┻━┻")
+				os.Exit(1)
 			} else {
 				fmt.Println("Succeed (ッ)")
 			}
@@ -0,0 +1,783 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Rules of a culture keyed by their category, failing the test on errors
+func testRules(test *testing.T, data map[string]string) map[string]Rule {
+	rules, err := parseRules(data)
+	if nil != err {
+		test.Fatal(err)
+	}
+	return rules
+}
+
+func TestTokenize(t *testing.T) {
+	for _, item := range []struct {
+		input, expected string
+	}{
+		{"n % 10 = 1..2", "n % 10 = 1 .. 2"},
+		{"i mod 100 != 12, 13", "i mod 100 != 12 , 13"},
+		{"n is not 1", "n is not 1"},
+		{"@integer 2~4, 22~24, …", "@integer 2 ~ 4 , 22 ~ 24 , …"},
+		{"@decimal 0.0~1.5, 1.5c6, 2e3, ...", "@decimal 0.0 ~ 1.5 , 1.5c6 , 2e3 , …"},
+		{"  \tn\r\n=0", "n = 0"},
+		{"", ""},
+	} {
+		tokens, err := tokenize(item.input)
+		if nil != err {
+			t.Errorf("`%s` : unexpected error %s", item.input, err)
+			continue
+		}
+
+		var texts []string
+		for _, token := range tokens[:len(tokens)-1] {
+			texts = append(texts, token.text)
+		}
+		if result := strings.Join(texts, " "); item.expected != result {
+			t.Errorf("`%s` : expected `%s` but got `%s`", item.input, item.expected, result)
+		}
+		if end := tokens[len(tokens)-1]; tokenEnd != end.kind || len([]rune(item.input)) != end.position {
+			t.Errorf("`%s` : expected the end of rule at %d but got %+v", item.input, len([]rune(item.input)), end)
+		}
+	}
+
+	tokens, _ := tokenize("n % 10 = 1.5 @integer …")
+	for idx, kind := range []int{tokenWord, tokenSymbol, tokenNumber, tokenSymbol, tokenNumber, tokenSamples, tokenSymbol, tokenEnd} {
+		if kind != tokens[idx].kind {
+			t.Errorf("token %d `%s` : expected kind %d but got %d", idx, tokens[idx].text, kind, tokens[idx].kind)
+		}
+	}
+}
+
+func TestTokenizeErrors(t *testing.T) {
+	for input, expected := range map[string]string{
+		"n < 1":      "Unexpected `<` at column 3 of `n < 1`",
+		"n ! 1":      "Unexpected `!` at column 3 of `n ! 1`",
+		"n = 1....2": "Unexpected `....` at column 6 of `n = 1....2`",
+		"N = 1":      "Unexpected `N` at column 1 of `N = 1`",
+		"n = 1 ½":    "Unexpected `½` at column 7 of `n = 1 ½`",
+	} {
+		if _, err := tokenize(input); nil == err || expected != err.Error() {
+			t.Errorf("`%s` : expected %s but got %v", input, expected, err)
+		}
+	}
+}
+
+func TestParseRule(t *testing.T) {
+	for _, item := range []struct {
+		input, expected string
+	}{
+		{"n = 1", "n = 1"},
+		{"i = 1 and v = 0 or n != 2..4", "i = 1 and v = 0 or n != 2..4"},
+		// Legacy syntax, still found in older CLDR releases
+		{"n is 1", "n = 1"},
+		{"n is not 1", "n != 1"},
+		{"n mod 10 in 2..4", "n % 10 = 2..4"},
+		{"n not in 2..4", "n != 2..4"},
+		{"n within 0..2", "n within 0..2"},
+		{"n mod 100 not within 2..4", "n % 100 not within 2..4"},
+		// `c` is a synonym of `e`
+		{"i = 1 and v = 0 or e = 0 and c != 1..5", "i = 1 and v = 0 or e = 0 and e != 1..5"},
+		{"n % 10 = 3..4,9 and n % 100 != 10..19,70..79,90..99", "n % 10 = 3..4,9 and n % 100 != 10..19,70..79,90..99"},
+		{"@integer 0, 2~16, …", ""},
+		{"", ""},
+	} {
+		rule, err := parseRule(item.input)
+		if nil != err {
+			t.Errorf("`%s` : unexpected error %s", item.input, err)
+		} else if result := rule.condition.String(); item.expected != result {
+			t.Errorf("`%s` : expected `%s` but got `%s`", item.input, item.expected, result)
+		} else if testing.Verbose() {
+			fmt.Printf("- `%s` is parsed as `%s`\n", item.input, result)
+		}
+	}
+}
+
+func TestParseRuleSamples(t *testing.T) {
+	rule, err := parseRule("n = 1 @integer 1, 21~23, 101 … @decimal 1.0~1.5, 1.0c3, …")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	for _, item := range []struct {
+		samples  Samples
+		expected string
+	}{
+		{rule.integers, "[{1 1} {21 23} {101 101}] true"},
+		{rule.decimals, "[{1.0 1.5} {1.0c3 1.0c3}] true"},
+	} {
+		if result := fmt.Sprintf("%v %v", item.samples.ranges, item.samples.infinite); item.expected != result {
+			t.Errorf("expected %s but got %s", item.expected, result)
+		}
+	}
+
+	if rule, _ := parseRule("@integer 3~17, 23"); rule.integers.infinite || 2 != len(rule.integers.ranges) {
+		t.Errorf("`@integer 3~17, 23` : unexpected samples %+v", rule.integers)
+	}
+}
+
+func TestParseRuleErrors(t *testing.T) {
+	for input, expected := range map[string]string{
+		"n = x":                    "Expected an integer but got `x` at column 5",
+		"n = 1.5":                  "Expected an integer but got `1.5` at column 5",
+		"n = 1 and":                "Expected an operand but got end of rule at column 10",
+		"x = 1":                    "Expected an operand but got `x` at column 1",
+		"n % 0 = 1":                "Modulo by zero at column 5",
+		"n = 5..2":                 "Empty range at column 8",
+		"n not 2":                  "Expected `in` or `within` but got `2` at column 7",
+		"n 1":                      "Expected a relation but got `1` at column 3",
+		"n = 1 1":                  "Unexpected `1` at column 7",
+		"n = 1 @float 1.5":         "Unknown sample type at column 7",
+		"@integer 1~":              "Expected a sample value but got end of rule at column 12",
+		"@integer , 1":             "Expected a sample value but got `,` at column 10",
+		"n = 99999999999999999999": "Invalid integer `99999999999999999999` at column 5",
+	} {
+		expected += " of `" + input + "`"
+		if _, err := parseRule(input); nil == err || expected != err.Error() {
+			t.Errorf("`%s` : expected %s but got %v", input, expected, err)
+		} else if testing.Verbose() {
+			fmt.Printf("- Got expected error %s\n", err)
+		}
+	}
+
+	if _, err := parseRules(map[string]string{"pluralRule-count-other": "n = 1"}); nil == err {
+		t.Errorf("`other` : expected an error for its condition")
+	}
+}
+
+func TestWithin(t *testing.T) {
+	// Unlike `in`, `within` matches the decimals of its ranges (the upper
+	// bound excluded), and `n` only equals an integer when `t` is 0
+	for _, item := range []struct {
+		rule, value string
+		expected    bool
+	}{
+		{"n within 1..3", "2", true},
+		{"n within 1..3", "1.5", true},
+		{"n within 1..3", "3.0", true},
+		{"n within 1..3", "3.5", false},
+		{"n within 1..3", "0.5", false},
+		{"n in 1..3", "1.5", false},
+		{"n in 1..3", "3.00", true},
+		{"n = 1", "1.0", true},
+		{"n != 1", "1.5", true},
+		{"n not within 1..3", "1.5", false},
+		{"n not within 1..3", "3.5", true},
+		{"n mod 10 within 1..3", "21.5", true},
+		{"n mod 10 in 1..3", "21.5", false},
+		{"i in 1..3", "1.5", true},
+		{"n within 1..3, 5", "5.5", false},
+	} {
+		rule, err := parseRule(item.rule)
+		if nil != err {
+			t.Errorf("`%s` : unexpected error %s", item.rule, err)
+			continue
+		}
+
+		operands, err := sample2operands(item.value)
+		if nil != err {
+			t.Errorf("`%s` : unexpected error %s", item.value, err)
+		} else if result := rule.condition.matches(operands); item.expected != result {
+			t.Errorf("`%s` <%s> : expected %v but got %v", item.rule, item.value, item.expected, result)
+		}
+	}
+}
+
+func TestSample2Operands(t *testing.T) {
+	for _, item := range []struct {
+		sample   string
+		expected Operands
+	}{
+		{"0", Operands{}},
+		{"17", Operands{i: 17}},
+		{"1.50", Operands{i: 1, v: 2, w: 1, f: 50, t: 5}},
+		{"0.0012", Operands{v: 4, w: 4, f: 12, t: 12}},
+		{"1.2c3", Operands{i: 1200, e: 3}},
+		{"1.25e1", Operands{i: 12, v: 1, w: 1, f: 5, t: 5, e: 1}},
+		{"0.0012c2", Operands{v: 2, w: 2, f: 12, t: 12, e: 2}},
+		{"2c0", Operands{i: 2}},
+	} {
+		if result, err := sample2operands(item.sample); nil != err {
+			t.Errorf("`%s` : unexpected error %s", item.sample, err)
+		} else if item.expected != result {
+			t.Errorf("`%s` : expected %+v but got %+v", item.sample, item.expected, result)
+		}
+	}
+
+	for _, sample := range []string{"", "x", "1c", "1.2.3", "99999999999999999999"} {
+		if result, err := sample2operands(sample); nil == err {
+			t.Errorf("`%s` : expected an error but got %+v", sample, result)
+		}
+	}
+}
+
+func TestExpandSample(t *testing.T) {
+	for _, item := range []struct {
+		from, to string
+		limit    int
+		expected string
+	}{
+		{"7", "7", 20, "7"},
+		{"2", "6", 20, "2 3 4 5 6"},
+		{"0.0", "0.5", 20, "0.0 0.1 0.2 0.3 0.4 0.5"},
+		// Stepping by the most precise bound
+		{"0.9", "1.05", 0, "0.90 0.91 0.92 0.93 0.94 0.95 0.96 0.97 0.98 0.99 1.00 1.01 1.02 1.03 1.04 1.05"},
+		// Compact decimal values are not expanded
+		{"1c3", "5c3", 20, "1c3 5c3"},
+		// At most `limit` values, the upper bound included
+		{"1", "100", 5, "1 26 51 100"},
+		{"0", "10", 3, "0 5 10"},
+	} {
+		values := expandSample(SampleRange{item.from, item.to}, item.limit)
+		if result := strings.Join(values, " "); item.expected != result {
+			t.Errorf("`%s~%s` (limit %d) : expected `%s` but got `%s`", item.from, item.to, item.limit, item.expected, result)
+		}
+	}
+
+	// No limit when checking samples
+	if values := expandSample(SampleRange{"0", "1000"}, 0); 1001 != len(values) {
+		t.Errorf("`0~1000` : expected 1001 values but got %d", len(values))
+	}
+	values := expandSample(SampleRange{"0", "1000"}, 20)
+	if len(values) > 20 || "0" != values[0] || "1000" != values[len(values)-1] {
+		t.Errorf("`0~1000` (limit 20) : unexpected values %v", values)
+	}
+}
+
+func TestExtraSamples(t *testing.T) {
+	en := testRules(t, map[string]string{"one": "i = 1 and v = 0", "other": ""})
+	ru := testRules(t, map[string]string{"one": "v = 0 and i % 10 = 1 and i % 100 != 11", "other": ""})
+
+	for _, item := range []struct {
+		rules    map[string]Rule
+		expected string
+		values   []string
+		result   string
+	}{
+		{en, "other", []string{"0", "2", "3"}, "10 12 13 100 102"},
+		{en, "other", []string{"0.0", "1.5"}, "10.0 11.5 100.0 101.5 1000.0"},
+		{ru, "one", []string{"1", "21", "31"}, "101 121 131 1001 1021"},
+		// Compact decimal values are left as is
+		{en, "other", []string{"1c3"}, ""},
+	} {
+		if result := strings.Join(extraSamples(item.expected, item.rules, item.values), " "); item.result != result {
+			t.Errorf("%v `%s` : expected `%s` but got `%s`", item.values, item.expected, item.result, result)
+		}
+	}
+}
+
+func TestCheckSamples(t *testing.T) {
+	for _, item := range []struct {
+		rules    map[string]string
+		expected []string
+	}{
+		{map[string]string{
+			"one":   "i = 1 and v = 0 @integer 1",
+			"other": "@integer 0, 2~16, 100, … @decimal 0.0~1.5, 10.0, …",
+		}, nil},
+		{map[string]string{
+			"one":   "n = 1 @integer 1, 2",
+			"other": "@integer 0, 3~4 @decimal 1.0, 1.5",
+		}, []string{
+			"cardinal sample `2`: expected `one` but got `other`",
+			"cardinal sample `1.0`: expected `other` but got `one`",
+		}},
+		{map[string]string{
+			"few":   "e = 0 and n within 2..4 @integer 2~4 @decimal 2.5, 4.5, 1.5c3",
+			"other": "@integer 0, 1, 5 @decimal 3.0c1",
+		}, []string{
+			"cardinal sample `4.5`: expected `few` but got `other`",
+			"cardinal sample `1.5c3`: expected `few` but got `other`",
+		}},
+	} {
+		result := checkSamples(testRules(t, item.rules), "cardinal")
+		if fmt.Sprint(item.expected) != fmt.Sprint(result) {
+			t.Errorf("%v :\n\texpected %q\n\tbut got  %q", item.rules, item.expected, result)
+		}
+	}
+}
+
+func TestAnalyzeRules(t *testing.T) {
+	samples, domain := analysisDomain()
+
+	for _, item := range []struct {
+		rules    map[string]string
+		expected []string
+	}{
+		{map[string]string{"one": "i = 1 and v = 0", "other": ""}, nil},
+		{map[string]string{"zero": "n = 0", "one": "n = 1", "two": "n = 2", "few": "n % 100 = 3..10", "many": "n % 100 = 11..99", "other": ""}, nil},
+		{map[string]string{"one": "n = 1", "few": "n in 1..4", "other": ""}, []string{
+			"`one` and `few` overlap on 4 value(s), eg. 1",
+			"results depend on the order of the cases (one, few)",
+		}},
+		{map[string]string{"one": "n in 0..5", "few": "n = 3", "other": ""}, []string{
+			"`one` and `few` overlap on 3 value(s), eg. 3",
+			"`few` is unreachable, always shadowed by a previous case",
+			"results depend on the order of the cases (one, few)",
+		}},
+		{map[string]string{"zero": "n = 3000000000", "other": ""}, []string{
+			"`zero` never matches",
+		}},
+		{map[string]string{"many": "i % 1 = 0", "other": ""}, []string{
+			"`other` is unreachable, always shadowed by a previous case",
+		}},
+	} {
+		result := analyzeRules(testRules(t, item.rules), samples, domain)
+		if fmt.Sprint(item.expected) != fmt.Sprint(result) {
+			t.Errorf("%v :\n\texpected %q\n\tbut got  %q", item.rules, item.expected, result)
+		} else if testing.Verbose() {
+			fmt.Printf("- Got expected diagnostics %q\n", result)
+		}
+	}
+}
+
+// Minimal plurals.json of the unicode-cldr/cldr-core layout
+const test_plurals_json = `{
+  "supplemental": {
+    "version": {"_number": "$Revision: 11229 $", "_cldrVersion": "27"},
+    "generation": {"_date": "$Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $"},
+    "plurals-type-cardinal": {
+      "fr": {"pluralRule-count-one": "i = 0,1 @integer 0, 1", "pluralRule-count-other": "@integer 2~17, 100, …"}
+    }
+  }
+}`
+
+// Minimal plurals.xml of the LDML distribution
+const test_plurals_xml = `<?xml version="1.0" encoding="UTF-8" ?>
+<supplementalData>
+    <version number="$Revision: 11229 $"/>
+    <plurals type="cardinal">
+        <pluralRules locales="fr pt_PT">
+            <pluralRule count="one">i = 0,1 @integer 0, 1</pluralRule>
+            <pluralRule count="other"> @integer 2~17, 100, …</pluralRule>
+        </pluralRules>
+    </plurals>
+</supplementalData>`
+
+// An archive holding the given files, in this order
+func testArchive(test *testing.T, files ...[2]string) *zip.Reader {
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for _, file := range files {
+		w, err := writer.Create(file[0])
+		if nil != err {
+			test.Fatal(err)
+		}
+		if _, err := w.Write([]byte(file[1])); nil != err {
+			test.Fatal(err)
+		}
+	}
+	if err := writer.Close(); nil != err {
+		test.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if nil != err {
+		test.Fatal(err)
+	}
+	return archive
+}
+
+func TestFindInArchive(t *testing.T) {
+	for _, item := range []struct {
+		files    []string
+		expected string
+	}{
+		// Releases of unicode-cldr/cldr-core
+		{[]string{"cldr-core-27.0.3/", "cldr-core-27.0.3/supplemental/ordinals.json", "cldr-core-27.0.3/supplemental/plurals.json"}, "cldr-core-27.0.3/supplemental/plurals.json"},
+		{[]string{"supplemental/plurals.json"}, "supplemental/plurals.json"},
+		// Releases of cldr-json, which also hold the supplemental data of other packages
+		{[]string{"cldr-json-44.0.0/cldr-json/cldr-bcp47/supplemental/plurals.json", "cldr-json-44.0.0/cldr-json/cldr-core/supplemental/plurals.json"}, "cldr-json-44.0.0/cldr-json/cldr-core/supplemental/plurals.json"},
+		{[]string{"/cldr-core/supplemental/plurals.json"}, "/cldr-core/supplemental/plurals.json"},
+		{[]string{"cldr-core-27.0.3/main/fr/plurals.json", "cldr-core-27.0.3/supplemental/ordinals.json"}, ""},
+		{[]string{"cldr-core-27.0.3/supplemental/myplurals.json"}, ""},
+	} {
+		var files [][2]string
+		for _, name := range item.files {
+			files = append(files, [2]string{name, ""})
+		}
+
+		var result string
+		if file := findInArchive(testArchive(t, files...), "plurals.json"); nil != file {
+			result = file.Name
+		}
+		if item.expected != result {
+			t.Errorf("%v : expected `%s` but got `%s`", item.files, item.expected, result)
+		}
+	}
+}
+
+func TestGetFromArchive(t *testing.T) {
+	for _, item := range []struct {
+		files   [][2]string
+		headers string
+	}{
+		{[][2]string{{"cldr-core-27.0.3/supplemental/plurals.json", test_plurals_json}},
+			"//\n// Archive: core.zip\n// Path: cldr-core-27.0.3/supplemental/plurals.json\n// $Revision: 11229 $\n// $Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $\n"},
+		{[][2]string{{"cldr-json-44.0.0/cldr-json/cldr-core/supplemental/plurals.json", test_plurals_json}},
+			"//\n// Archive: core.zip\n// Path: cldr-json-44.0.0/cldr-json/cldr-core/supplemental/plurals.json\n// $Revision: 11229 $\n// $Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $\n"},
+		// The XML distribution of CLDR, when the archive has no JSON data
+		{[][2]string{{"common/supplemental/plurals.xml", test_plurals_xml}},
+			"//\n// Archive: core.zip\n// Path: common/supplemental/plurals.xml\n// $Revision: 11229 $\n"},
+		{[][2]string{{"common/supplemental/plurals.xml", test_plurals_xml}, {"supplemental/plurals.json", test_plurals_json}},
+			"//\n// Archive: core.zip\n// Path: supplemental/plurals.json\n// $Revision: 11229 $\n// $Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $\n"},
+	} {
+		var headers string
+		data, err := getFromArchive(testArchive(t, item.files...), "core.zip", "plurals", "cardinal", &headers)
+		if nil != err {
+			t.Errorf("%s : unexpected error %s", item.files[0][0], err)
+			continue
+		}
+		if item.headers != headers {
+			t.Errorf("%s : expected headers %q but got %q", item.files[0][0], item.headers, headers)
+		}
+		if rule := data["fr"]["pluralRule-count-one"]; "i = 0,1 @integer 0, 1" != rule {
+			t.Errorf("%s : unexpected rule `%s` of fr", item.files[0][0], rule)
+		}
+	}
+
+	var headers string
+	archive := testArchive(t, [2]string{"cldr-core-27.0.3/supplemental/plurals.json", test_plurals_json})
+	if _, err := getFromArchive(archive, "core.zip", "ordinals", "ordinal", &headers); nil == err || "Neither `ordinals.json` nor `ordinals.xml` found in archive" != err.Error() {
+		t.Errorf("ordinals : expected a missing file error but got %v", err)
+	}
+	if "" != headers {
+		t.Errorf("ordinals : unexpected headers %q", headers)
+	}
+}
+
+func TestParseXML(t *testing.T) {
+	document := []byte(`<supplementalData>
+    <version number="$Revision: 11229 $"/>
+    <generation date="$Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $"/>
+    <plurals type="ordinal">
+        <pluralRules locales="en">
+            <pluralRule count="one">n % 10 = 1 and n % 100 != 11 @integer 1, 21, 31, …</pluralRule>
+            <pluralRule count="other"> @integer 0, 4~18, 100, …</pluralRule>
+        </pluralRules>
+    </plurals>
+    <plurals type="cardinal">
+        <pluralRules locales="fr pt_PT
+            ff">
+            <pluralRule count="one">i = 0,1 @integer 0, 1</pluralRule>
+            <pluralRule count="other"> @integer 2~17, 100, …</pluralRule>
+        </pluralRules>
+        <pluralRules locales="en">
+            <pluralRule count="one">i = 1 and v = 0 @integer 1</pluralRule>
+            <pluralRule count="other"> @integer 0, 2~16, 100, …</pluralRule>
+        </pluralRules>
+    </plurals>
+</supplementalData>`)
+
+	var headers string
+	data, err := parse(document, "File: plurals.xml", "cardinal", &headers)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if expected := "//\n// File: plurals.xml\n// $Revision: 11229 $\n// $Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $\n"; expected != headers {
+		t.Errorf("expected headers %q but got %q", expected, headers)
+	}
+
+	// Every culture of a space separated `locales` attribute, with the tags of the JSON distribution
+	for culture, expected := range map[string]string{
+		"fr":    "i = 0,1 @integer 0, 1",
+		"pt-PT": "i = 0,1 @integer 0, 1",
+		"ff":    "i = 0,1 @integer 0, 1",
+		"en":    "i = 1 and v = 0 @integer 1",
+	} {
+		if result := data[culture]["pluralRule-count-one"]; expected != result {
+			t.Errorf("%s : expected `%s` but got `%s`", culture, expected, result)
+		}
+	}
+	if 4 != len(data) {
+		t.Errorf("expected 4 cultures but got %v", data)
+	}
+	if result := data["en"]["pluralRule-count-other"]; "@integer 0, 2~16, 100, …" != result {
+		t.Errorf("en : unexpected `other` rule `%s`", result)
+	}
+
+	data, err = parse(document, "File: plurals.xml", "ordinal", &headers)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := data["en"]["pluralRule-count-one"]; 1 != len(data) || "n % 10 = 1 and n % 100 != 11 @integer 1, 21, 31, …" != result {
+		t.Errorf("ordinal : unexpected rules %v", data)
+	}
+
+	document = []byte(`<supplementalData><plurals type="ordinal"><pluralRules locales="en"><pluralRule count="other"/></pluralRules></plurals></supplementalData>`)
+	if _, err := parse(document, "File: ordinals.xml", "cardinal", &headers); nil == err || "No `cardinal` plural rules found" != err.Error() {
+		t.Errorf("ordinals.xml : expected an error for its missing cardinal rules but got %v", err)
+	}
+	if _, err := parse([]byte(`<supplementalData/>`), "File: other.xml", "ordinal", &headers); nil == err {
+		t.Errorf("other.xml : expected an error")
+	}
+}
+
+func TestParseXMLLocales(t *testing.T) {
+	for _, item := range []struct {
+		key, document, expected string
+	}{
+		{"parents", `<supplementalData>
+    <parentLocales>
+        <parentLocale parent="pt_PT" locales="pt_AO pt_MZ"/>
+        <parentLocale parent="root" locales="zh_Hant"/>
+    </parentLocales>
+    <parentLocales component="segmentations">
+        <parentLocale parent="en" locales="en_GB"/>
+    </parentLocales>
+</supplementalData>`, "map[parentLocale:map[pt-AO:pt-PT pt-MZ:pt-PT zh-Hant:root]]"},
+		{"aliases", `<supplementalData><metadata><alias>
+    <languageAlias type="iw" replacement="he" reason="deprecated"/>
+    <languageAlias type="sh" replacement="sr_Latn" reason="legacy"/>
+</alias></metadata></supplementalData>`, "map[languageAlias:map[iw:he sh:sr_Latn]]"},
+		{"ranges", `<supplementalData><plurals>
+    <pluralRanges locales="fr pt_PT">
+        <pluralRange start="one" end="other" result="other"/>
+        <pluralRange start="one" end="one" result="one"/>
+    </pluralRanges>
+</plurals></supplementalData>`, "map[fr:map[pluralRange-start-one-end-one:one pluralRange-start-one-end-other:other] pt-PT:map[pluralRange-start-one-end-one:one pluralRange-start-one-end-other:other]]"},
+	} {
+		var headers string
+		data, err := parse([]byte(item.document), "File: "+item.key+".xml", item.key, &headers)
+		if nil != err {
+			t.Errorf("%s : unexpected error %s", item.key, err)
+		} else if result := fmt.Sprint(data); item.expected != result {
+			t.Errorf("%s : expected %s but got %s", item.key, item.expected, result)
+		}
+
+		if _, err := parse([]byte(`<supplementalData/>`), "File: "+item.key+".xml", item.key, &headers); nil == err {
+			t.Errorf("%s : expected an error for missing data", item.key)
+		}
+	}
+}
+
+func TestCldrPath(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"core/supplemental/plurals.json",
+		"core/supplemental/ordinals.json",
+		"flat/plurals.json",
+		"cldr/common/supplemental/plurals.xml",
+		"xml/supplemental/plurals.xml",
+		"xml/supplemental/ordinals.json",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); nil != err {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, nil, 0644); nil != err {
+			t.Fatal(err)
+		}
+	}
+
+	for _, item := range []struct {
+		dir, name, expected string
+	}{
+		// Root of a cldr-core tree, or its "supplemental" directory
+		{"core", "plurals", "core/supplemental/plurals.json"},
+		{"core/supplemental", "ordinals", "core/supplemental/ordinals.json"},
+		{"flat", "plurals", "flat/plurals.json"},
+		// XML distribution of CLDR, JSON data first
+		{"cldr", "plurals", "cldr/common/supplemental/plurals.xml"},
+		{"xml", "plurals", "xml/supplemental/plurals.xml"},
+		{"xml", "ordinals", "xml/supplemental/ordinals.json"},
+		// Missing files are looked up where cldr-core puts them
+		{"core", "parentLocales", "core/supplemental/parentLocales.json"},
+		{"missing", "plurals", "missing/supplemental/plurals.json"},
+	} {
+		result := cldrPath(filepath.Join(dir, filepath.FromSlash(item.dir)), item.name)
+		if expected := filepath.Join(dir, filepath.FromSlash(item.expected)); expected != result {
+			t.Errorf("%s %s : expected `%s` but got `%s`", item.dir, item.name, expected, result)
+		}
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	for _, item := range []struct {
+		document, headers string
+	}{
+		{test_plurals_json, "//\n// File: plurals.json\n// $Revision: 11229 $\n// $Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $\n"},
+		// Recent releases only give the CLDR version
+		{`{"supplemental": {"version": {"_unicodeVersion": "15.1.0", "_cldrVersion": "44"},
+			"plurals-type-cardinal": {"fr": {"pluralRule-count-other": ""}}}}`, "//\n// File: plurals.json\n// CLDR 44\n"},
+	} {
+		var headers string
+		data, err := parse([]byte(item.document), "File: plurals.json", "cardinal", &headers)
+		if nil != err {
+			t.Errorf("%s : unexpected error %s", item.headers, err)
+			continue
+		}
+		if item.headers != headers {
+			t.Errorf("expected headers %q but got %q", item.headers, headers)
+		}
+		if _, ok := data["fr"]["pluralRule-count-other"]; !ok {
+			t.Errorf("%q : missing `other` rule of fr in %v", item.headers, data)
+		}
+	}
+
+	for document, expected := range map[string]string{
+		`{"main": {}}`: "Data does not appear to be CLDR data",
+		`{"supplemental": {"version": {"_cldrVersion": "44"}}}`:                               "No `plurals-type-ordinal` data found",
+		`{"supplemental": {"version": {"_cldrVersion": "44"}, "plurals-type-ordinal": null}}`: "No `plurals-type-ordinal` data found",
+	} {
+		var headers string
+		if _, err := parse([]byte(document), "File: ordinals.json", "ordinal", &headers); nil == err || expected != err.Error() {
+			t.Errorf("%s : expected %s but got %v", document, expected, err)
+		}
+	}
+}
+
+func TestLoadLocales(t *testing.T) {
+	sources := map[string]map[string]map[string]string{
+		"parentLocales.json": {"parentLocale": {"pt-AO": "pt-PT", "es-MX": "es-419", "zh-Hant": "root"}},
+		"aliases.json": {"languageAlias": {
+			"iw":         "he",
+			"sh":         "sr_Latn",
+			"art_lojban": "jbo",
+			"cnr":        "sr_ME",
+			"aju":        "jrb",
+			"sgn_BR":     "bzs",
+			"hy":         "hy",
+			"zh_guoyu":   "zh",
+			"xx":         "",
+		}},
+		"pluralRanges.json": {
+			"fr": {"pluralRange-start-one-end-one": "one", "pluralRange-start-one-end-other": "other", "pluralRange-start-one-end-few": "few"},
+			"he": {"pluralRange-start-two-end-many": "many"},
+			"xx": {"pluralRange-start-one-end-other": "other"},
+		},
+	}
+	load := func(source, key string, headers *string) (map[string]map[string]string, error) {
+		data, ok := sources[source]
+		if !ok {
+			return nil, fmt.Errorf("open %s: no such file or directory", source)
+		}
+		*headers += "//\n// File: " + source + "\n"
+		return data, nil
+	}
+	plurals := map[string]map[string]string{
+		"fr": {"pluralRule-count-one": "", "pluralRule-count-other": ""},
+		"he": {"pluralRule-count-one": "", "pluralRule-count-two": "", "pluralRule-count-other": ""},
+		"sr": {"pluralRule-count-one": "", "pluralRule-count-other": ""},
+		"zh": {"pluralRule-count-other": ""},
+		"hy": {"pluralRule-count-one": "", "pluralRule-count-other": ""},
+	}
+
+	var headers string
+	result := loadLocales(load, []string{"missing.json", "parentLocales.json"}, []string{"aliases.json"}, []string{"pluralRanges.json"}, plurals, &headers)
+	if expected := "//\n// File: parentLocales.json\n//\n// File: aliases.json\n//\n// File: pluralRanges.json\n"; expected != headers {
+		t.Errorf("expected headers %q but got %q", expected, headers)
+	}
+
+	// The root locale is where the truncation of a tag ends anyway
+	if expected := "map[es-MX:es-419 pt-AO:pt-PT]"; expected != fmt.Sprint(result.Parents) {
+		t.Errorf("expected parents %s but got %v", expected, result.Parents)
+	}
+
+	// Only the aliases replacing a code by a language having rules
+	if expected := "map[cnr:sr-ME iw:he sh:sr-Latn zh-guoyu:zh]"; expected != fmt.Sprint(result.Aliases) {
+		t.Errorf("expected aliases %s but got %v", expected, result.Aliases)
+	}
+
+	// Only the ranges of the cultures having rules, in the order of the categories,
+	// the ones whose categories are unknown to the rules being skipped
+	if expected := "map[fr:[{one one one} {one other other}]]"; expected != fmt.Sprint(result.Ranges) {
+		t.Errorf("expected ranges %s but got %v", expected, result.Ranges)
+	}
+
+	headers = ""
+	result = loadLocales(load, []string{"missing.json"}, nil, []string{"missing.json"}, plurals, &headers)
+	if nil != result.Parents || nil != result.Aliases || nil != result.Ranges {
+		t.Errorf("expected no locale data but got %+v", result)
+	}
+	// The generated headers tell what the package lacks
+	if expected := "//\n// No parent locales, only falling back by truncation\n//\n// No language aliases, deprecated codes are left as is\n//\n// No plural ranges, a range falls into the category of its end\n"; expected != headers {
+		t.Errorf("expected headers %q but got %q", expected, headers)
+	}
+}
+
+func TestDomain2Code(t *testing.T) {
+	var samples []string
+	for i := 0; i < 18; i++ {
+		samples = append(samples, fmt.Sprint(i))
+	}
+	samples = append(samples, "1.5c3")
+
+	expected := "\t\"0\", \"1\", \"2\", \"3\", \"4\", \"5\", \"6\", \"7\", \"8\", \"9\", \"10\", \"11\", \"12\", \"13\", \"14\", \"15\",\n\t\"16\", \"17\", \"1.5c3\","
+	if result := domain2code(samples, "\t"); expected != result {
+		t.Errorf("expected\n%s\nbut got\n%s", expected, result)
+	}
+	if result := domain2code(nil, "\t"); "" != result {
+		t.Errorf("expected no code but got `%s`", result)
+	}
+}
+
+func TestRanges2Test(t *testing.T) {
+	rules, err := parseRules(map[string]string{
+		"pluralRule-count-one":   "i = 0,1 @integer 0, 1 @decimal 0.0~1.5",
+		"pluralRule-count-other": " @integer 2~17, 100, 1000, 10000, 100000, 1000000, … @decimal 2.0~3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0, …",
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	// A range goes from the first sample of its start category to the first
+	// greater sample of its end one, the ones of unknown categories are skipped
+	ranges := []PluralRange{{"one", "one", "one"}, {"one", "other", "other"}, {"few", "other", "other"}}
+	var result []string
+	for _, test := range ranges2test("fr", ranges, rules) {
+		result = append(result, test.toString())
+	}
+	expected := "testRange(t, \"fr\", 0, 1, `one`)\ntestRange(t, \"fr\", 0, 2, `other`)"
+	if strings.Join(result, "\n") != expected {
+		t.Errorf("expected\n%s\nbut got\n%s", expected, strings.Join(result, "\n"))
+	}
+}
+
+func TestRangeSources(t *testing.T) {
+	plurals := map[string]string{
+		"pluralRule-count-one":   "i = 0,1 @integer 0, 1",
+		"pluralRule-count-other": " @integer 2~4",
+	}
+	rules, err := parseRules(plurals)
+	if nil != err {
+		t.Fatal(err)
+	}
+	ranges := []PluralRange{{"one", "other", "other"}}
+	locales := LocaleData{Ranges: map[string][]PluralRange{"fr": ranges}}
+
+	dir := t.TempDir()
+	tests := []Source{UnitTestSource{"fr", ranges2test("fr", ranges, rules), plurals, nil}}
+	funcs := []Source{FuncSource{"fr", "", "\treturn Other\n", []string{"fr"}, false}}
+	for _, item := range []struct {
+		tmpl     string
+		items    []Source
+		expected string
+	}{
+		{"plural_test.tmpl", tests, "testRange(t, \"fr\", 0, 2, `other`)"},
+		{"plural.tmpl", funcs, "\"fr\": { { One, Other }: Other, },"},
+	} {
+		path := filepath.Join(dir, item.tmpl+".go")
+		if err := createSource(item.tmpl, path, "//", item.items, locales, ""); nil != err {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadFile(path)
+		if nil != err {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), item.expected) {
+			t.Errorf("%s : expected `%s` in\n%s", item.tmpl, item.expected, data)
+		} else if testing.Verbose() {
+			fmt.Printf("- Got expected range code `%s`\n", item.expected)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package plural
+
+import (
+	"fmt"
+)
+
+// Plural category of a value, see http://unicode.org/reports/tr35/tr35-numbers.html#Language_Plural_Rules
+type Category int
+
+const (
+	Zero Category = iota
+	One
+	Two
+	Few
+	Many
+	Other
+)
+
+// Returned by a Func (or an OperandsFunc) asked for the ordinal category of a
+// value when neither the culture nor its parents have ordinal rules (see
+// HasOrdinal). It is not a CLDR category: String gives "NoOrdinal", but it
+// cannot be marshalled.
+const NoOrdinal Category = -1
+
+var category_names = [...]string{"zero", "one", "two", "few", "many", "other"}
+
+// Returns the CLDR name of the category ("zero", "one"...)
+func (c Category) String() string {
+	if NoOrdinal == c {
+		return "NoOrdinal"
+	} else if c < Zero || c > Other {
+		return fmt.Sprintf("Category(%d)", int(c))
+	}
+	return category_names[c]
+}
+
+func (c Category) MarshalText() ([]byte, error) {
+	if c < Zero || c > Other {
+		return nil, fmt.Errorf("UnknownCategory: `%d`", int(c))
+	}
+	return []byte(category_names[c]), nil
+}
+
+func (c *Category) UnmarshalText(text []byte) error {
+	category, err := ParseCategory(string(text))
+	if nil != err {
+		return err
+	}
+	*c = category
+	return nil
+}
+
+// Returns the category of a CLDR name ("zero", "one"...)
+func ParseCategory(name string) (Category, error) {
+	for i, category_name := range category_names {
+		if name == category_name {
+			return Category(i), nil
+		}
+	}
+	return Other, fmt.Errorf("UnknownCategory: `%s`", name)
+}
@@ -0,0 +1,121 @@
+package plural
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func testCategory(test *testing.T, category Category, expected string) {
+	if result := category.String(); expected != result {
+		test.Errorf("`%d` : expected `%s` but got `%s`", int(category), expected, result)
+		return
+	}
+
+	parsed, err := ParseCategory(expected)
+	if nil != err {
+		test.Errorf("`%s` : unexpected error %s", expected, err)
+	} else if category != parsed {
+		test.Errorf("`%s` : expected %d but got %d", expected, int(category), int(parsed))
+	} else if testing.Verbose() {
+		fmt.Printf("- Got expected category <%s>\n", expected)
+	}
+}
+
+func TestCategory(t *testing.T) {
+	testCategory(t, Zero, "zero")
+	testCategory(t, One, "one")
+	testCategory(t, Two, "two")
+	testCategory(t, Few, "few")
+	testCategory(t, Many, "many")
+	testCategory(t, Other, "other")
+
+	if result := Category(42).String(); "Category(42)" != result {
+		t.Errorf("expected `Category(42)` but got `%s`", result)
+	}
+	if result := NoOrdinal.String(); "NoOrdinal" != result {
+		t.Errorf("expected `NoOrdinal` but got `%s`", result)
+	}
+
+	if _, err := ParseCategory("One"); nil == err {
+		t.Errorf("`One` : expected an error")
+	}
+}
+
+func TestCategoryText(t *testing.T) {
+	data, err := json.Marshal(map[string][]Category{"categories": {One, Few, Other}})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if expected := `{"categories":["one","few","other"]}`; expected != string(data) {
+		t.Errorf("expected %s but got %s", expected, data)
+	}
+
+	var result map[string][]Category
+	if err := json.Unmarshal(data, &result); nil != err {
+		t.Fatal(err)
+	}
+	if 3 != len(result["categories"]) || One != result["categories"][0] || Few != result["categories"][1] || Other != result["categories"][2] {
+		t.Errorf("unexpected %v", result)
+	}
+
+	if _, err := NoOrdinal.MarshalText(); nil == err {
+		t.Errorf("`NoOrdinal` : expected an error")
+	}
+
+	if err := json.Unmarshal([]byte(`["several"]`), &[]Category{}); nil == err {
+		t.Errorf("`several` : expected an error")
+	}
+}
+
+func TestGetFunc(t *testing.T) {
+	skipWithout(t, "fr")
+	// The string based API still returns the CLDR names
+	fn, err := GetFunc("fr")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(1, false); "one" != result {
+		t.Errorf("expected `one` but got `%s`", result)
+	}
+	if result := fn(2, false); "other" != result {
+		t.Errorf("expected `other` but got `%s`", result)
+	}
+
+	if _, err := GetFunc("xx"); nil == err {
+		t.Errorf("`xx` : expected an error")
+	}
+}
+
+func TestNoOrdinal(t *testing.T) {
+	skipWithout(t, "be")
+	// CLDR has no ordinal rules for Belarusian
+	fn, err := GetCategoryFunc("be")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(2, true); NoOrdinal != result {
+		t.Errorf("be `2` (ordinal) : expected `NoOrdinal` but got `%s`", result)
+	}
+	if result := fn(2, false); Few != result {
+		t.Errorf("be `2` : expected `few` but got `%s`", result)
+	}
+
+	ops_fn, err := GetOperandsFunc("be")
+	if nil != err {
+		t.Fatal(err)
+	}
+	ops, _ := NewOperands(2)
+	if result := ops_fn(ops, true); NoOrdinal != result {
+		t.Errorf("be `2` (ordinal) : expected `NoOrdinal` but got `%s`", result)
+	}
+
+	// Whereas the string based API still applies the cardinal rules
+	named, err := GetFunc("be")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := named(2, true); "few" != result {
+		t.Errorf("be `2` (ordinal) : expected `few` but got `%s`", result)
+	}
+}
@@ -0,0 +1,51 @@
+package plural
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Greatest exponent expanded by compact
+const max_exponent = 1000
+
+func compact(strval string) (string, int) {
+	// Numbers written in compact decimal notation, eg. "1.2c6" (or "1.2e6"),
+	// are expanded ("1200000") and their exponent is the `e` operand.
+	pos := strings.IndexAny(strval, "ceCE")
+	if -1 == pos {
+		return strval, 0
+	}
+
+	exponent, err := strconv.Atoi(strval[pos+1:])
+	if nil != err || exponent < 0 || exponent > max_exponent {
+		return strval, 0
+	}
+
+	mantissa, sign := strval[:pos], ""
+	if strings.HasPrefix(mantissa, "-") {
+		mantissa, sign = mantissa[1:], "-"
+	}
+
+	integer, fraction := mantissa, ""
+	if pos := strings.Index(mantissa, "."); -1 != pos {
+		integer, fraction = mantissa[:pos], mantissa[pos+1:]
+	}
+
+	if exponent >= len(fraction) {
+		integer += fraction + strings.Repeat("0", exponent-len(fraction))
+		fraction = ""
+	} else {
+		integer += fraction[:exponent]
+		fraction = fraction[exponent:]
+	}
+
+	integer = strings.TrimLeft(integer, "0")
+	if "" == integer {
+		integer = "0"
+	}
+
+	if "" == fraction {
+		return sign + integer, exponent
+	}
+	return sign + integer + "." + fraction, exponent
+}
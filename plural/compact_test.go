@@ -0,0 +1,38 @@
+package plural
+
+import (
+	"fmt"
+	"testing"
+)
+
+func testCompact(test *testing.T, value, expected_value string, expected_e int) {
+	result, e := compact(value)
+	if expected_value != result || expected_e != e {
+		test.Errorf("`%v` :", value)
+		if expected_value != result {
+			test.Errorf("\texpected value = %q but got %q", expected_value, result)
+		}
+		if expected_e != e {
+			test.Errorf("\texpected e = %d but got %d", expected_e, e)
+		}
+	} else if testing.Verbose() {
+		fmt.Printf("- Got expected results for <%v>\n", value)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	testCompact(t, "1", "1", 0)
+	testCompact(t, "1.5", "1.5", 0)
+	testCompact(t, "1c6", "1000000", 6)
+	testCompact(t, "1e6", "1000000", 6)
+	testCompact(t, "1.2c6", "1200000", 6)
+	testCompact(t, "1.20c3", "1200", 3)
+	testCompact(t, "1.2345c3", "1234.5", 3)
+	testCompact(t, "-1.5c1", "-15", 1)
+	testCompact(t, "0.05c1", "0.5", 1)
+	testCompact(t, "1c0", "1", 0)
+	testCompact(t, "1.5E3", "1500", 3)
+	testCompact(t, "1cx", "1cx", 0)
+	testCompact(t, "1c-1", "1c-1", 0)
+	testCompact(t, "1c1001", "1c1001", 0)
+}
@@ -0,0 +1,367 @@
+package plural
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A relation of a compiled rule, eg. `n % 10 = 1..2`
+type relation struct {
+	operand byte
+	modulus uint64
+	negated bool
+	// Unlike `in`, `n within` also matches the decimal values of its ranges
+	within bool
+	ranges [][2]uint64
+}
+
+// Relations of each `and_condition` of a compiled rule, any of them may match
+type condition [][]relation
+
+type ruleToken struct {
+	kind     int
+	text     string
+	position int
+}
+
+// Kinds of ruleToken
+const (
+	tokenEnd = iota
+	tokenWord
+	tokenNumber
+	tokenSymbol
+)
+
+type ruleParser struct {
+	tokens []ruleToken
+	offset int
+}
+
+// Returns a plural function from CLDR rules given at runtime, eg.
+// {One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2~16"}, evaluated
+// as the generated functions are: the categories are checked from Zero to
+// Many, values matching none of them being Other. The samples (following
+// `@`) are ignored, and so is the `ordinal` argument of the function. As in
+// CLDR, the rules must include Other, without any condition.
+func Compile(rules map[Category]string) (Func, error) {
+	fn, err := compileRules(rules)
+	if nil != err {
+		return nil, err
+	}
+	return func(value interface{}, ordinal bool) Category {
+		// Values which are not numbers (see NewOperands) are handled as 0
+		ops, _ := NewOperands(value)
+		return fn(ops, ordinal)
+	}, nil
+}
+
+func compileRules(rules map[Category]string) (OperandsFunc, error) {
+	var conditions [Other]condition
+	for category, rule := range rules {
+		if category < Zero || category > Other {
+			return nil, fmt.Errorf("UnknownCategory: `%d`", int(category))
+		}
+
+		result, err := parseCondition(rule)
+		if nil != err {
+			return nil, fmt.Errorf("InvalidRule: `%s` (%s: %s)", rule, category, err)
+		}
+
+		if Other == category {
+			if nil != result {
+				return nil, fmt.Errorf("InvalidRule: `%s` (other: Unexpected condition)", rule)
+			}
+		} else {
+			conditions[category] = result
+		}
+	}
+
+	if _, ok := rules[Other]; !ok {
+		return nil, fmt.Errorf("MissingCategory: `other`")
+	}
+
+	return func(ops Operands, ordinal bool) Category {
+		for category, condition := range conditions {
+			if condition.matches(ops) {
+				return Category(category)
+			}
+		}
+		return Other
+	}, nil
+}
+
+func (x condition) matches(ops Operands) bool {
+	for _, relations := range x {
+		matches := true
+		for _, relation := range relations {
+			if !relation.matches(ops) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func (x relation) matches(ops Operands) bool {
+	var value uint64
+	switch x.operand {
+	case 'n':
+		value = ops.n()
+	case 'i':
+		value = ops.I
+	case 'v':
+		value = uint64(ops.V)
+	case 'w':
+		value = uint64(ops.W)
+	case 'f':
+		value = ops.F
+	case 't':
+		value = ops.T
+	case 'e':
+		value = uint64(ops.E)
+	}
+
+	integer := ops.I
+	if 0 != x.modulus {
+		value = mod(value, x.modulus)
+		integer %= x.modulus
+	}
+
+	for _, bounds := range x.ranges {
+		var in bool
+		if x.within && 'n' == x.operand && bounds[0] != bounds[1] {
+			in = within(value, integer, bounds[0], bounds[1])
+		} else {
+			in = value >= bounds[0] && value <= bounds[1]
+		}
+
+		if in {
+			return !x.negated
+		}
+	}
+	return x.negated
+}
+
+// Returns the condition of a rule, nil if it has none (as `other` does)
+func parseCondition(rule string) (condition, error) {
+	// Samples are not needed to evaluate the rule
+	if pos := strings.IndexRune(rule, '@'); -1 != pos {
+		rule = rule[:pos]
+	}
+
+	tokens, err := tokenizeRule(rule)
+	if nil != err {
+		return nil, err
+	}
+
+	parser := &ruleParser{tokens, 0}
+	if tokenEnd == parser.peek().kind {
+		return nil, nil
+	}
+
+	var result condition
+	for {
+		var relations []relation
+		for {
+			relation, err := parser.relation()
+			if nil != err {
+				return nil, err
+			}
+			relations = append(relations, relation)
+
+			if !parser.accept(tokenWord, "and") {
+				break
+			}
+		}
+		result = append(result, relations)
+
+		if !parser.accept(tokenWord, "or") {
+			break
+		}
+	}
+
+	if token := parser.peek(); tokenEnd != token.kind {
+		return nil, parser.fail(token, "Unexpected "+token.String())
+	}
+	return result, nil
+}
+
+func tokenizeRule(rule string) ([]ruleToken, error) {
+	var result []ruleToken
+
+	chars := []rune(rule)
+	max := len(chars)
+	for pos := 0; pos < max; {
+		char := chars[pos]
+		start := pos
+
+		switch {
+		case ' ' == char || '\t' == char || '\n' == char || '\r' == char:
+			pos++
+			continue
+
+		case char >= 'a' && char <= 'z':
+			for pos < max && chars[pos] >= 'a' && chars[pos] <= 'z' {
+				pos++
+			}
+			result = append(result, ruleToken{tokenWord, string(chars[start:pos]), start})
+
+		case char >= '0' && char <= '9':
+			for pos < max && chars[pos] >= '0' && chars[pos] <= '9' {
+				pos++
+			}
+			result = append(result, ruleToken{tokenNumber, string(chars[start:pos]), start})
+
+		case '.' == char && pos+1 < max && '.' == chars[pos+1]:
+			pos += 2
+			result = append(result, ruleToken{tokenSymbol, "..", start})
+
+		case '!' == char && pos+1 < max && '=' == chars[pos+1]:
+			pos += 2
+			result = append(result, ruleToken{tokenSymbol, "!=", start})
+
+		case '=' == char, '%' == char, ',' == char:
+			pos++
+			result = append(result, ruleToken{tokenSymbol, string(char), start})
+
+		default:
+			return nil, fmt.Errorf("Unexpected `%c` at column %d", char, start+1)
+		}
+	}
+	return append(result, ruleToken{tokenEnd, "", max}), nil
+}
+
+// Returns the token quoted, or "end of rule"
+func (x ruleToken) String() string {
+	if tokenEnd == x.kind {
+		return "end of rule"
+	}
+	return "`" + x.text + "`"
+}
+
+func (x *ruleParser) peek() ruleToken {
+	return x.tokens[x.offset]
+}
+
+func (x *ruleParser) next() ruleToken {
+	token := x.tokens[x.offset]
+	if tokenEnd != token.kind {
+		x.offset++
+	}
+	return token
+}
+
+func (x *ruleParser) accept(kind int, text string) bool {
+	if token := x.peek(); kind == token.kind && text == token.text {
+		x.offset++
+		return true
+	}
+	return false
+}
+
+// Returns an error located at a token
+func (x *ruleParser) fail(token ruleToken, message string) error {
+	return fmt.Errorf("%s at column %d", message, token.position+1)
+}
+
+func (x *ruleParser) relation() (relation, error) {
+	var result relation
+
+	token := x.next()
+	if tokenWord != token.kind || 1 != len(token.text) || -1 == strings.Index("nifvtwce", token.text) {
+		return result, x.fail(token, "Expected an operand but got "+token.String())
+	}
+	result.operand = token.text[0]
+	// `c` and `e` are synonyms for the compact decimal exponent
+	if 'c' == result.operand {
+		result.operand = 'e'
+	}
+
+	if x.accept(tokenSymbol, "%") || x.accept(tokenWord, "mod") {
+		token = x.peek()
+		value, err := x.value()
+		if nil != err {
+			return result, err
+		}
+		if 0 == value {
+			return result, x.fail(token, "Modulo by zero")
+		}
+		result.modulus = value
+	}
+
+	token = x.next()
+	switch {
+	case tokenSymbol == token.kind && "=" == token.text:
+
+	case tokenSymbol == token.kind && "!=" == token.text:
+		result.negated = true
+
+	case tokenWord == token.kind && "is" == token.text:
+		result.negated = x.accept(tokenWord, "not")
+
+		value, err := x.value()
+		if nil != err {
+			return result, err
+		}
+		result.ranges = [][2]uint64{{value, value}}
+		return result, nil
+
+	case tokenWord == token.kind && "not" == token.text:
+		result.negated = true
+		token = x.next()
+		if tokenWord != token.kind || ("in" != token.text && "within" != token.text) {
+			return result, x.fail(token, "Expected `in` or `within` but got "+token.String())
+		}
+		result.within = "within" == token.text
+
+	case tokenWord == token.kind && "in" == token.text:
+
+	case tokenWord == token.kind && "within" == token.text:
+		result.within = true
+
+	default:
+		return result, x.fail(token, "Expected a relation but got "+token.String())
+	}
+
+	for {
+		from, err := x.value()
+		if nil != err {
+			return result, err
+		}
+
+		to := from
+		if x.accept(tokenSymbol, "..") {
+			token = x.peek()
+			to, err = x.value()
+			if nil != err {
+				return result, err
+			}
+			if to < from {
+				return result, x.fail(token, "Empty range")
+			}
+		}
+		result.ranges = append(result.ranges, [2]uint64{from, to})
+
+		if !x.accept(tokenSymbol, ",") {
+			return result, nil
+		}
+	}
+}
+
+func (x *ruleParser) value() (uint64, error) {
+	token := x.next()
+	if tokenNumber != token.kind {
+		return 0, x.fail(token, "Expected an integer but got "+token.String())
+	}
+
+	result, err := strconv.ParseUint(token.text, 10, 64)
+	if nil != err || result >= fractional {
+		return 0, x.fail(token, "Invalid integer "+token.String())
+	}
+	return result, nil
+}
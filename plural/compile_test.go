@@ -0,0 +1,106 @@
+package plural
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Operands of the values make-plural checks the rules on, as generated in
+// plural_domain: integers, decimals with up to 3 visible fraction digits and
+// numbers in compact decimal notation
+func compileDomain() []Operands {
+	var result []Operands
+	for _, sample := range plural_domain {
+		ops, _ := ParseOperands(sample)
+		result = append(result, ops)
+	}
+	return result
+}
+
+func TestCompile(t *testing.T) {
+	domain := compileDomain()
+
+	for culture, rules := range plural_rules {
+		expected := plural_funcs[culture]
+		for idx, ordinal := range []bool{false, true} {
+			source := rules[idx]
+			if nil == source {
+				// No ordinal rules, the generated function returns NoOrdinal
+				for _, ops := range domain {
+					if result := expected(ops, ordinal); NoOrdinal != result {
+						t.Errorf("%s %+v (ordinal: %v) : expected `NoOrdinal` but got `%s`", culture, ops, ordinal, result)
+						break
+					}
+				}
+				continue
+			}
+
+			fn, err := compileRules(source)
+			if nil != err {
+				t.Errorf("%s : unexpected error %s", culture, err)
+				continue
+			}
+
+			failures := 0
+			for _, ops := range domain {
+				if result := fn(ops, ordinal); expected(ops, ordinal) != result && failures < 5 {
+					t.Errorf("%s %+v (ordinal: %v) : expected `%s` but got `%s`", culture, ops, ordinal, expected(ops, ordinal), result)
+					failures++
+				}
+			}
+		}
+	}
+
+	if testing.Verbose() {
+		fmt.Printf("- Compiled rules of %d cultures agree with the generated functions\n", len(plural_rules))
+	}
+}
+
+func TestCompileFunc(t *testing.T) {
+	// Russian rules, plus compact numbers from 100 to 102 (decimals included)
+	fn, err := Compile(map[Category]string{
+		One:   "v = 0 and i % 10 = 1 and i % 100 != 11",
+		Few:   "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 @integer 2~4, 22~24",
+		Many:  "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14",
+		Other: "@decimal 0.0~1.5",
+		Zero:  "n within 100..102 and e is not 0",
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	for value, expected := range map[interface{}]Category{1: One, 21: One, 11: Many, 22: Few, "1.5": Other, 100: Many, "1c2": Zero, "1.015c2": Zero, "1.025c2": Other, "1c3": Many} {
+		if result := fn(value, false); expected != result {
+			t.Errorf("`%v` : expected `%s` but got `%s`", value, expected, result)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, item := range []struct {
+		rules    map[Category]string
+		expected string
+	}{
+		{map[Category]string{One: "n = x"}, "InvalidRule: `n = x` (one: Expected an integer but got `x` at column 5)"},
+		{map[Category]string{One: "n = 1 and"}, "InvalidRule: `n = 1 and` (one: Expected an operand but got end of rule at column 10)"},
+		{map[Category]string{One: "nv = 1"}, "InvalidRule: `nv = 1` (one: Expected an operand but got `nv` at column 1)"},
+		{map[Category]string{One: "1 = n"}, "InvalidRule: `1 = n` (one: Expected an operand but got `1` at column 1)"},
+		{map[Category]string{One: "n 1"}, "InvalidRule: `n 1` (one: Expected a relation but got `1` at column 3)"},
+		{map[Category]string{One: "n = 18446744073709551616"}, "InvalidRule: `n = 18446744073709551616` (one: Invalid integer `18446744073709551616` at column 5)"},
+		{map[Category]string{One: "n % 0 = 1"}, "InvalidRule: `n % 0 = 1` (one: Modulo by zero at column 5)"},
+		{map[Category]string{Few: "n = 5..2"}, "InvalidRule: `n = 5..2` (few: Empty range at column 8)"},
+		{map[Category]string{Few: "n not 2"}, "InvalidRule: `n not 2` (few: Expected `in` or `within` but got `2` at column 7)"},
+		{map[Category]string{Few: "n < 2"}, "InvalidRule: `n < 2` (few: Unexpected `<` at column 3)"},
+		{map[Category]string{Many: "n is 1 1"}, "InvalidRule: `n is 1 1` (many: Unexpected `1` at column 8)"},
+		{map[Category]string{Other: "n = 1"}, "InvalidRule: `n = 1` (other: Unexpected condition)"},
+		{map[Category]string{Category(7): "n = 1"}, "UnknownCategory: `7`"},
+		{map[Category]string{One: "n = 1"}, "MissingCategory: `other`"},
+		{map[Category]string{}, "MissingCategory: `other`"},
+	} {
+		if _, err := Compile(item.rules); nil == err || item.expected != err.Error() {
+			t.Errorf("%v : expected %s but got %v", item.rules, item.expected, err)
+		} else if testing.Verbose() {
+			fmt.Printf("- Got expected error %s\n", err)
+		}
+	}
+}
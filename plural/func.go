@@ -1,3090 +1,1709 @@
 // Generated by https://github.com/gotnospirit/makeplural
-// at Tue, 09 Jun 2015 18:02:38 +0200
+// at Sat, 17 Oct 2026 04:41:56 +0000
 //
-// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/ordinals.json
+// File: ../cldr-core-27-rebuilt/supplemental/ordinals.json
 // $Revision: 11229 $
 // $Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $
 //
-// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/plurals.json
+// File: ../cldr-core-27-rebuilt/supplemental/plurals.json
 // $Revision: 11229 $
 // $Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $
+//
+// No parent locales, only falling back by truncation
+//
+// No language aliases, deprecated codes are left as is
+//
+// No plural ranges, a range falls into the category of its end
 
-package plural
-
-import (
-	"fmt"
-	"math"
-	"strconv"
-)
-
-func mod(x, y float64) float64 {
-	return math.Mod(x, y)
-}
-
-func float(v interface{}) float64 {
-	switch v.(type) {
-	case int:
-		return float64(v.(int))
-
-	case int64:
-		return float64(v.(int64))
-
-	case float64:
-		return v.(float64)
-
-	case string:
-		floatval, err := strconv.ParseFloat(v.(string), 64)
-		if nil != err {
-			return 0.0
-		}
-		return floatval
-	}
-	return 0.0
-}
-
-var plural_funcs map[string]func(interface{}, bool) string
-
-func init() {
-	plural_funcs = make(map[string]func(interface{}, bool) string)
-
-	plural_funcs["af"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ak"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["am"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ar"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-		n100 := mod(n, 100)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 0:
-			return "zero"
-
-		case n == 1:
-			return "one"
-
-		case n == 2:
-			return "two"
-
-		case n100 == 3, n100 == 4, n100 == 5, n100 == 6, n100 == 7, n100 == 8, n100 == 9, n100 == 10:
-			return "few"
-
-		case n100 == 11, n100 == 12, n100 == 13, n100 == 14, n100 == 15, n100 == 16, n100 == 17, n100 == 18, n100 == 19, n100 == 20, n100 == 21, n100 == 22, n100 == 23, n100 == 24, n100 == 25, n100 == 26, n100 == 27, n100 == 28, n100 == 29, n100 == 30, n100 == 31, n100 == 32, n100 == 33, n100 == 34, n100 == 35, n100 == 36, n100 == 37, n100 == 38, n100 == 39, n100 == 40, n100 == 41, n100 == 42, n100 == 43, n100 == 44, n100 == 45, n100 == 46, n100 == 47, n100 == 48, n100 == 49, n100 == 50, n100 == 51, n100 == 52, n100 == 53, n100 == 54, n100 == 55, n100 == 56, n100 == 57, n100 == 58, n100 == 59, n100 == 60, n100 == 61, n100 == 62, n100 == 63, n100 == 64, n100 == 65, n100 == 66, n100 == 67, n100 == 68, n100 == 69, n100 == 70, n100 == 71, n100 == 72, n100 == 73, n100 == 74, n100 == 75, n100 == 76, n100 == 77, n100 == 78, n100 == 79, n100 == 80, n100 == 81, n100 == 82, n100 == 83, n100 == 84, n100 == 85, n100 == 86, n100 == 87, n100 == 88, n100 == 89, n100 == 90, n100 == 91, n100 == 92, n100 == 93, n100 == 94, n100 == 95, n100 == 96, n100 == 97, n100 == 98, n100 == 99:
-			return "many"
-		}
-	}
-
-	plural_funcs["as"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1, n == 5, n == 7, n == 8, n == 9, n == 10:
-				return "one"
-
-			case n == 2, n == 3:
-				return "two"
-
-			case n == 4:
-				return "few"
-
-			case n == 6:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["asa"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ast"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["az"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-		i10 := i % 10
-		i100 := i % 100
-		i1000 := i % 1000
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case i10 == 1, i10 == 2, i10 == 5, i10 == 7, i10 == 8, i100 == 20, i100 == 50, i100 == 70, i100 == 80:
-				return "one"
-
-			case i10 == 3, i10 == 4, i1000 == 100, i1000 == 200, i1000 == 300, i1000 == 400, i1000 == 500, i1000 == 600, i1000 == 700, i1000 == 800, i1000 == 900:
-				return "few"
-
-			case i == 0, i10 == 6, i100 == 40, i100 == 60, i100 == 90:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["be"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-		n10 := mod(n, 10)
-		n100 := mod(n, 100)
-
-		switch {
-		default:
-			return "other"
-
-		case n10 == 1 && n100 != 11:
-			return "one"
-
-		case (n10 == 2 || n10 == 3 || n10 == 4) && n100 != 12 && n100 != 13 && n100 != 14:
-			return "few"
-
-		case n10 == 0, n10 == 5, n10 == 6, n10 == 7, n10 == 8, n10 == 9, n100 == 11, n100 == 12, n100 == 13, n100 == 14:
-			return "many"
-		}
-	}
-
-	plural_funcs["bem"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["bez"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["bg"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["bh"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["bm"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["bn"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1, n == 5, n == 7, n == 8, n == 9, n == 10:
-				return "one"
-
-			case n == 2, n == 3:
-				return "two"
-
-			case n == 4:
-				return "few"
-
-			case n == 6:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["bo"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["br"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-		n10 := mod(n, 10)
-		n100 := mod(n, 100)
-		n1000000 := mod(n, 1000000)
-
-		switch {
-		default:
-			return "other"
-
-		case n10 == 1 && n100 != 11 && n100 != 71 && n100 != 91:
-			return "one"
-
-		case n10 == 2 && n100 != 12 && n100 != 72 && n100 != 92:
-			return "two"
-
-		case (n10 == 3 || n10 == 4 || n10 == 9) && n100 != 10 && n100 != 11 && n100 != 12 && n100 != 13 && n100 != 14 && n100 != 15 && n100 != 16 && n100 != 17 && n100 != 18 && n100 != 19 && n100 != 70 && n100 != 71 && n100 != 72 && n100 != 73 && n100 != 74 && n100 != 75 && n100 != 76 && n100 != 77 && n100 != 78 && n100 != 79 && n100 != 90 && n100 != 91 && n100 != 92 && n100 != 93 && n100 != 94 && n100 != 95 && n100 != 96 && n100 != 97 && n100 != 98 && n100 != 99:
-			return "few"
-
-		case n != 0 && n1000000 == 0:
-			return "many"
-		}
-	}
-
-	plural_funcs["brx"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["bs"] = func(value interface{}, ordinal bool) string {
-		f, i, _, v, _, _ := finvtw(value)
-		i10 := i % 10
-		i100 := i % 100
-		f10 := f % 10
-		f100 := f % 100
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case v == 0 && i10 == 1 && i100 != 11, f10 == 1 && f100 != 11:
-			return "one"
-
-		case v == 0 && (i10 == 2 || i10 == 3 || i10 == 4) && i100 != 12 && i100 != 13 && i100 != 14, (f10 == 2 || f10 == 3 || f10 == 4) && f100 != 12 && f100 != 13 && f100 != 14:
-			return "few"
-		}
-	}
-
-	plural_funcs["ca"] = func(value interface{}, ordinal bool) string {
-		_, i, n, v, _, _ := finvtw(value)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1, n == 3:
-				return "one"
-
-			case n == 2:
-				return "two"
-
-			case n == 4:
-				return "few"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["ce"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["cgg"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["chr"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ckb"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["cs"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-
-		case (i == 2 || i == 3 || i == 4) && v == 0:
-			return "few"
-
-		case v != 0:
-			return "many"
-		}
-	}
-
-	plural_funcs["cy"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 0, n == 7, n == 8, n == 9:
-				return "zero"
-
-			case n == 1:
-				return "one"
-
-			case n == 2:
-				return "two"
-
-			case n == 3, n == 4:
-				return "few"
-
-			case n == 5, n == 6:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 0:
-			return "zero"
-
-		case n == 1:
-			return "one"
-
-		case n == 2:
-			return "two"
-
-		case n == 3:
-			return "few"
-
-		case n == 6:
-			return "many"
-		}
-	}
-
-	plural_funcs["da"] = func(value interface{}, ordinal bool) string {
-		_, i, n, _, t, _ := finvtw(value)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1, t != 0 && (i == 0 || i == 1):
-			return "one"
-		}
-	}
-
-	plural_funcs["de"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["dsb"] = func(value interface{}, ordinal bool) string {
-		f, i, _, v, _, _ := finvtw(value)
-		i100 := i % 100
-		f100 := f % 100
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case v == 0 && i100 == 1, f100 == 1:
-			return "one"
-
-		case v == 0 && i100 == 2, f100 == 2:
-			return "two"
-
-		case v == 0 && (i100 == 3 || i100 == 4), f100 == 3, f100 == 4:
-			return "few"
-		}
-	}
-
-	plural_funcs["dv"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["dz"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["ee"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["el"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["en"] = func(value interface{}, ordinal bool) string {
-		_, i, n, v, _, _ := finvtw(value)
-		n10 := mod(n, 10)
-		n100 := mod(n, 100)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n10 == 1 && n100 != 11:
-				return "one"
-
-			case n10 == 2 && n100 != 12:
-				return "two"
-
-			case n10 == 3 && n100 != 13:
-				return "few"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["eo"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["es"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["et"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["eu"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["fa"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ff"] = func(value interface{}, ordinal bool) string {
-		i := int64(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, i == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["fi"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["fil"] = func(value interface{}, ordinal bool) string {
-		f, i, n, v, _, _ := finvtw(value)
-		i10 := i % 10
-		f10 := f % 10
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1:
-				return "one"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case v == 0 && (i == 1 || i == 2 || i == 3), v == 0 && i10 != 4 && i10 != 6 && i10 != 9, v != 0 && f10 != 4 && f10 != 6 && f10 != 9:
-			return "one"
-		}
-	}
-
-	plural_funcs["fo"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["fr"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1:
-				return "one"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, i == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["fur"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["fy"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["ga"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-
-		case n == 2:
-			return "two"
-
-		case n == 3, n == 4, n == 5, n == 6:
-			return "few"
-
-		case n == 7, n == 8, n == 9, n == 10:
-			return "many"
-		}
-	}
-
-	plural_funcs["gd"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1, n == 11:
-			return "one"
-
-		case n == 2, n == 12:
-			return "two"
-
-		case n == 3, n == 4, n == 5, n == 6, n == 7, n == 8, n == 9, n == 10, n == 13, n == 14, n == 15, n == 16, n == 17, n == 18, n == 19:
-			return "few"
-		}
-	}
-
-	plural_funcs["gl"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["gsw"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["gu"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1:
-				return "one"
-
-			case n == 2, n == 3:
-				return "two"
-
-			case n == 4:
-				return "few"
-
-			case n == 6:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["guw"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["gv"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-		i10 := i % 10
-		i100 := i % 100
-
-		switch {
-		default:
-			return "other"
-
-		case v == 0 && i10 == 1:
-			return "one"
-
-		case v == 0 && i10 == 2:
-			return "two"
-
-		case v == 0 && (i100 == 0 || i100 == 20 || i100 == 40 || i100 == 60 || i100 == 80):
-			return "few"
-
-		case v != 0:
-			return "many"
-		}
-	}
-
-	plural_funcs["ha"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["haw"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["he"] = func(value interface{}, ordinal bool) string {
-		_, i, n, v, _, _ := finvtw(value)
-		n10 := mod(n, 10)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-
-		case i == 2 && v == 0:
-			return "two"
-
-		case v == 0 && n != 0 && n != 1 && n != 2 && n != 3 && n != 4 && n != 5 && n != 6 && n != 7 && n != 8 && n != 9 && n != 10 && n10 == 0:
-			return "many"
-		}
-	}
-
-	plural_funcs["hi"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1:
-				return "one"
-
-			case n == 2, n == 3:
-				return "two"
-
-			case n == 4:
-				return "few"
-
-			case n == 6:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["hr"] = func(value interface{}, ordinal bool) string {
-		f, i, _, v, _, _ := finvtw(value)
-		i10 := i % 10
-		i100 := i % 100
-		f10 := f % 10
-		f100 := f % 100
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case v == 0 && i10 == 1 && i100 != 11, f10 == 1 && f100 != 11:
-			return "one"
-
-		case v == 0 && (i10 == 2 || i10 == 3 || i10 == 4) && i100 != 12 && i100 != 13 && i100 != 14, (f10 == 2 || f10 == 3 || f10 == 4) && f100 != 12 && f100 != 13 && f100 != 14:
-			return "few"
-		}
-	}
-
-	plural_funcs["hsb"] = func(value interface{}, ordinal bool) string {
-		f, i, _, v, _, _ := finvtw(value)
-		i100 := i % 100
-		f100 := f % 100
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case v == 0 && i100 == 1, f100 == 1:
-			return "one"
-
-		case v == 0 && i100 == 2, f100 == 2:
-			return "two"
-
-		case v == 0 && (i100 == 3 || i100 == 4), f100 == 3, f100 == 4:
-			return "few"
-		}
-	}
-
-	plural_funcs["hu"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1, n == 5:
-				return "one"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["hy"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1:
-				return "one"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, i == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["id"] = func(value interface{}, ordinal bool) string {
-		if ordinal {
-			return "other"
-		}
-
-		return "other"
-	}
-
-	plural_funcs["ig"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["ii"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["in"] = func(value interface{}, ordinal bool) string {
-		if ordinal {
-			return "other"
-		}
-
-		return "other"
-	}
-
-	plural_funcs["is"] = func(value interface{}, ordinal bool) string {
-		_, i, _, _, t, _ := finvtw(value)
-		i10 := i % 10
-		i100 := i % 100
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case t == 0 && i10 == 1 && i100 != 11, t != 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["it"] = func(value interface{}, ordinal bool) string {
-		_, i, n, v, _, _ := finvtw(value)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 11, n == 8, n == 80, n == 800:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["iu"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-
-		case n == 2:
-			return "two"
-		}
-	}
-
-	plural_funcs["iw"] = func(value interface{}, ordinal bool) string {
-		_, i, n, v, _, _ := finvtw(value)
-		n10 := mod(n, 10)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-
-		case i == 2 && v == 0:
-			return "two"
-
-		case v == 0 && n != 0 && n != 1 && n != 2 && n != 3 && n != 4 && n != 5 && n != 6 && n != 7 && n != 8 && n != 9 && n != 10 && n10 == 0:
-			return "many"
-		}
-	}
-
-	plural_funcs["ja"] = func(value interface{}, ordinal bool) string {
-		if ordinal {
-			return "other"
-		}
-
-		return "other"
-	}
-
-	plural_funcs["jbo"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["jgo"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ji"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-
-		switch {
-		default:
-			return "other"
-
-		case i == 1 && v == 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["jmc"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["jv"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["jw"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["ka"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-		i100 := i % 100
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case i == 1:
-				return "one"
-
-			case i == 0, i100 == 2, i100 == 3, i100 == 4, i100 == 5, i100 == 6, i100 == 7, i100 == 8, i100 == 9, i100 == 10, i100 == 11, i100 == 12, i100 == 13, i100 == 14, i100 == 15, i100 == 16, i100 == 17, i100 == 18, i100 == 19, i100 == 20, i100 == 40, i100 == 60, i100 == 80:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["kab"] = func(value interface{}, ordinal bool) string {
-		i := int64(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, i == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["kaj"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["kcg"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["kde"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["kea"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["kk"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-		n10 := mod(n, 10)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n10 == 6, n10 == 9, n10 == 0 && n != 0:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["kkj"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["kl"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["km"] = func(value interface{}, ordinal bool) string {
-		if ordinal {
-			return "other"
-		}
-
-		return "other"
-	}
-
-	plural_funcs["kn"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ko"] = func(value interface{}, ordinal bool) string {
-		if ordinal {
-			return "other"
-		}
-
-		return "other"
-	}
-
-	plural_funcs["ks"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ksb"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ksh"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 0:
-			return "zero"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["ku"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["kw"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-
-		case n == 2:
-			return "two"
-		}
-	}
-
-	plural_funcs["ky"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["lag"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		switch {
-		default:
-			return "other"
-
-		case n == 0:
-			return "zero"
-
-		case (i == 0 || i == 1) && n != 0:
-			return "one"
-		}
-	}
-
-	plural_funcs["lb"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["lg"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["lkt"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
-
-	plural_funcs["ln"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 0, n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["lo"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1:
-				return "one"
-			}
-		}
+package plural
 
-		return "other"
+// Returns x % y, unless x is `n` with a fraction
+func mod(x, y uint64) uint64 {
+	if fractional == x {
+		return x
 	}
+	return x % y
+}
 
-	plural_funcs["lt"] = func(value interface{}, ordinal bool) string {
-		f, _, n, _, _, _ := finvtw(value)
-		n10 := mod(n, 10)
-		n100 := mod(n, 100)
+// Checks whether x (`n` or its modulo) lies within lower..upper, `integer`
+// being its integer digits when it has a fraction
+func within(x, integer, lower, upper uint64) bool {
+	if fractional == x {
+		return integer >= lower && integer < upper
+	}
+	return x >= lower && x <= upper
+}
 
-		if ordinal {
-			return "other"
-		}
+// Returns the plural category of a value, either cardinal or ordinal (NoOrdinal
+// for a culture without ordinal rules)
+type Func func(value interface{}, ordinal bool) Category
 
-		switch {
-		default:
-			return "other"
+// Returns the plural category of the operands of a value, either cardinal or
+// ordinal (NoOrdinal for a culture without ordinal rules)
+type OperandsFunc func(ops Operands, ordinal bool) Category
 
-		case n10 == 1 && n100 != 11 && n100 != 12 && n100 != 13 && n100 != 14 && n100 != 15 && n100 != 16 && n100 != 17 && n100 != 18 && n100 != 19:
-			return "one"
+var plural_funcs map[string]OperandsFunc
 
-		case (n10 == 2 || n10 == 3 || n10 == 4 || n10 == 5 || n10 == 6 || n10 == 7 || n10 == 8 || n10 == 9) && n100 != 11 && n100 != 12 && n100 != 13 && n100 != 14 && n100 != 15 && n100 != 16 && n100 != 17 && n100 != 18 && n100 != 19:
-			return "few"
+// Cultures having ordinal rules, the others only have cardinal ones
+var plural_ordinals map[string]bool
 
-		case f != 0:
-			return "many"
-		}
-	}
+// Explicit CLDR parents of locales, the others fall back by truncating their tags
+var plural_parents = map[string]string{}
 
-	plural_funcs["lv"] = func(value interface{}, ordinal bool) string {
-		f, _, n, v, _, _ := finvtw(value)
-		n10 := mod(n, 10)
-		n100 := mod(n, 100)
-		f100 := f % 100
-		f10 := f % 10
+// Replacements of deprecated language codes (and of some whole tags), eg. "iw" => "he"
+var plural_aliases = map[string]string{}
 
-		if ordinal {
-			return "other"
-		}
+// Category of a range, by culture and categories of its start and end
+var plural_ranges = map[string]map[[2]Category]Category{}
 
-		switch {
-		default:
-			return "other"
+func init() {
+	plural_funcs = make(map[string]OperandsFunc)
+	plural_ordinals = make(map[string]bool)
+
+	plural_funcs["af"] = plural_af
+	plural_ordinals["af"] = true
+	plural_funcs["bg"] = plural_af
+	plural_ordinals["bg"] = true
+	plural_funcs["ce"] = plural_af
+	plural_ordinals["ce"] = true
+	plural_funcs["el"] = plural_af
+	plural_ordinals["el"] = true
+	plural_funcs["es"] = plural_af
+	plural_ordinals["es"] = true
+	plural_funcs["eu"] = plural_af
+	plural_ordinals["eu"] = true
+	plural_funcs["ky"] = plural_af
+	plural_ordinals["ky"] = true
+	plural_funcs["ml"] = plural_af
+	plural_ordinals["ml"] = true
+	plural_funcs["mn"] = plural_af
+	plural_ordinals["mn"] = true
+	plural_funcs["nb"] = plural_af
+	plural_ordinals["nb"] = true
+	plural_funcs["ta"] = plural_af
+	plural_ordinals["ta"] = true
+	plural_funcs["te"] = plural_af
+	plural_ordinals["te"] = true
+	plural_funcs["tr"] = plural_af
+	plural_ordinals["tr"] = true
+	plural_funcs["uz"] = plural_af
+	plural_ordinals["uz"] = true
+	plural_funcs["ak"] = plural_ak
+	plural_funcs["bh"] = plural_ak
+	plural_funcs["guw"] = plural_ak
+	plural_funcs["ln"] = plural_ak
+	plural_funcs["mg"] = plural_ak
+	plural_funcs["nso"] = plural_ak
+	plural_funcs["ti"] = plural_ak
+	plural_funcs["wa"] = plural_ak
+	plural_funcs["am"] = plural_am
+	plural_ordinals["am"] = true
+	plural_funcs["fa"] = plural_am
+	plural_ordinals["fa"] = true
+	plural_funcs["kn"] = plural_am
+	plural_ordinals["kn"] = true
+	plural_funcs["zu"] = plural_am
+	plural_ordinals["zu"] = true
+	plural_funcs["ar"] = plural_ar
+	plural_ordinals["ar"] = true
+	plural_funcs["as"] = plural_as
+	plural_ordinals["as"] = true
+	plural_funcs["bn"] = plural_as
+	plural_ordinals["bn"] = true
+	plural_funcs["asa"] = plural_asa
+	plural_funcs["bem"] = plural_asa
+	plural_funcs["bez"] = plural_asa
+	plural_funcs["brx"] = plural_asa
+	plural_funcs["cgg"] = plural_asa
+	plural_funcs["chr"] = plural_asa
+	plural_funcs["ckb"] = plural_asa
+	plural_funcs["dv"] = plural_asa
+	plural_funcs["ee"] = plural_asa
+	plural_funcs["eo"] = plural_asa
+	plural_funcs["fo"] = plural_asa
+	plural_funcs["fur"] = plural_asa
+	plural_funcs["gsw"] = plural_asa
+	plural_funcs["ha"] = plural_asa
+	plural_funcs["haw"] = plural_asa
+	plural_funcs["jgo"] = plural_asa
+	plural_funcs["jmc"] = plural_asa
+	plural_funcs["kaj"] = plural_asa
+	plural_funcs["kcg"] = plural_asa
+	plural_funcs["kkj"] = plural_asa
+	plural_funcs["kl"] = plural_asa
+	plural_funcs["ks"] = plural_asa
+	plural_funcs["ksb"] = plural_asa
+	plural_funcs["ku"] = plural_asa
+	plural_funcs["lb"] = plural_asa
+	plural_funcs["lg"] = plural_asa
+	plural_funcs["mas"] = plural_asa
+	plural_funcs["mgo"] = plural_asa
+	plural_funcs["nah"] = plural_asa
+	plural_funcs["nd"] = plural_asa
+	plural_funcs["nn"] = plural_asa
+	plural_funcs["nnh"] = plural_asa
+	plural_funcs["no"] = plural_asa
+	plural_funcs["nr"] = plural_asa
+	plural_funcs["ny"] = plural_asa
+	plural_funcs["nyn"] = plural_asa
+	plural_funcs["om"] = plural_asa
+	plural_funcs["or"] = plural_asa
+	plural_funcs["os"] = plural_asa
+	plural_funcs["pap"] = plural_asa
+	plural_funcs["ps"] = plural_asa
+	plural_funcs["rm"] = plural_asa
+	plural_funcs["rof"] = plural_asa
+	plural_funcs["rwk"] = plural_asa
+	plural_funcs["saq"] = plural_asa
+	plural_funcs["seh"] = plural_asa
+	plural_funcs["sn"] = plural_asa
+	plural_funcs["so"] = plural_asa
+	plural_funcs["ss"] = plural_asa
+	plural_funcs["ssy"] = plural_asa
+	plural_funcs["st"] = plural_asa
+	plural_funcs["syr"] = plural_asa
+	plural_funcs["teo"] = plural_asa
+	plural_funcs["tig"] = plural_asa
+	plural_funcs["tk"] = plural_asa
+	plural_funcs["tn"] = plural_asa
+	plural_funcs["ts"] = plural_asa
+	plural_funcs["ug"] = plural_asa
+	plural_funcs["ve"] = plural_asa
+	plural_funcs["vo"] = plural_asa
+	plural_funcs["vun"] = plural_asa
+	plural_funcs["wae"] = plural_asa
+	plural_funcs["xh"] = plural_asa
+	plural_funcs["xog"] = plural_asa
+	plural_funcs["ast"] = plural_ast
+	plural_funcs["ji"] = plural_ast
+	plural_funcs["yi"] = plural_ast
+	plural_funcs["az"] = plural_az
+	plural_ordinals["az"] = true
+	plural_funcs["be"] = plural_be
+	plural_funcs["bm"] = plural_bm
+	plural_funcs["bo"] = plural_bm
+	plural_funcs["dz"] = plural_bm
+	plural_funcs["ig"] = plural_bm
+	plural_funcs["ii"] = plural_bm
+	plural_funcs["jbo"] = plural_bm
+	plural_funcs["jv"] = plural_bm
+	plural_funcs["jw"] = plural_bm
+	plural_funcs["kde"] = plural_bm
+	plural_funcs["kea"] = plural_bm
+	plural_funcs["lkt"] = plural_bm
+	plural_funcs["nqo"] = plural_bm
+	plural_funcs["sah"] = plural_bm
+	plural_funcs["ses"] = plural_bm
+	plural_funcs["sg"] = plural_bm
+	plural_funcs["to"] = plural_bm
+	plural_funcs["wo"] = plural_bm
+	plural_funcs["yo"] = plural_bm
+	plural_funcs["br"] = plural_br
+	plural_funcs["bs"] = plural_bs
+	plural_ordinals["bs"] = true
+	plural_funcs["hr"] = plural_bs
+	plural_ordinals["hr"] = true
+	plural_funcs["sh"] = plural_bs
+	plural_ordinals["sh"] = true
+	plural_funcs["sr"] = plural_bs
+	plural_ordinals["sr"] = true
+	plural_funcs["ca"] = plural_ca
+	plural_ordinals["ca"] = true
+	plural_funcs["cs"] = plural_cs
+	plural_ordinals["cs"] = true
+	plural_funcs["sk"] = plural_cs
+	plural_ordinals["sk"] = true
+	plural_funcs["cy"] = plural_cy
+	plural_ordinals["cy"] = true
+	plural_funcs["da"] = plural_da
+	plural_ordinals["da"] = true
+	plural_funcs["de"] = plural_de
+	plural_ordinals["de"] = true
+	plural_funcs["et"] = plural_de
+	plural_ordinals["et"] = true
+	plural_funcs["fi"] = plural_de
+	plural_ordinals["fi"] = true
+	plural_funcs["fy"] = plural_de
+	plural_ordinals["fy"] = true
+	plural_funcs["gl"] = plural_de
+	plural_ordinals["gl"] = true
+	plural_funcs["nl"] = plural_de
+	plural_ordinals["nl"] = true
+	plural_funcs["sw"] = plural_de
+	plural_ordinals["sw"] = true
+	plural_funcs["ur"] = plural_de
+	plural_ordinals["ur"] = true
+	plural_funcs["dsb"] = plural_dsb
+	plural_ordinals["dsb"] = true
+	plural_funcs["hsb"] = plural_dsb
+	plural_ordinals["hsb"] = true
+	plural_funcs["en"] = plural_en
+	plural_ordinals["en"] = true
+	plural_funcs["ff"] = plural_ff
+	plural_funcs["kab"] = plural_ff
+	plural_funcs["fil"] = plural_fil
+	plural_ordinals["fil"] = true
+	plural_funcs["tl"] = plural_fil
+	plural_ordinals["tl"] = true
+	plural_funcs["fr"] = plural_fr
+	plural_ordinals["fr"] = true
+	plural_funcs["hy"] = plural_fr
+	plural_ordinals["hy"] = true
+	plural_funcs["ga"] = plural_ga
+	plural_funcs["gd"] = plural_gd
+	plural_funcs["gu"] = plural_gu
+	plural_ordinals["gu"] = true
+	plural_funcs["hi"] = plural_gu
+	plural_ordinals["hi"] = true
+	plural_funcs["gv"] = plural_gv
+	plural_funcs["he"] = plural_he
+	plural_ordinals["he"] = true
+	plural_funcs["iw"] = plural_he
+	plural_ordinals["iw"] = true
+	plural_funcs["hu"] = plural_hu
+	plural_ordinals["hu"] = true
+	plural_funcs["id"] = plural_id
+	plural_ordinals["id"] = true
+	plural_funcs["in"] = plural_id
+	plural_ordinals["in"] = true
+	plural_funcs["ja"] = plural_id
+	plural_ordinals["ja"] = true
+	plural_funcs["km"] = plural_id
+	plural_ordinals["km"] = true
+	plural_funcs["ko"] = plural_id
+	plural_ordinals["ko"] = true
+	plural_funcs["my"] = plural_id
+	plural_ordinals["my"] = true
+	plural_funcs["root"] = plural_id
+	plural_ordinals["root"] = true
+	plural_funcs["th"] = plural_id
+	plural_ordinals["th"] = true
+	plural_funcs["zh"] = plural_id
+	plural_ordinals["zh"] = true
+	plural_funcs["is"] = plural_is
+	plural_ordinals["is"] = true
+	plural_funcs["it"] = plural_it
+	plural_ordinals["it"] = true
+	plural_funcs["iu"] = plural_iu
+	plural_funcs["kw"] = plural_iu
+	plural_funcs["naq"] = plural_iu
+	plural_funcs["se"] = plural_iu
+	plural_funcs["sma"] = plural_iu
+	plural_funcs["smi"] = plural_iu
+	plural_funcs["smj"] = plural_iu
+	plural_funcs["smn"] = plural_iu
+	plural_funcs["sms"] = plural_iu
+	plural_funcs["ka"] = plural_ka
+	plural_ordinals["ka"] = true
+	plural_funcs["kk"] = plural_kk
+	plural_ordinals["kk"] = true
+	plural_funcs["ksh"] = plural_ksh
+	plural_funcs["lag"] = plural_lag
+	plural_funcs["lo"] = plural_lo
+	plural_ordinals["lo"] = true
+	plural_funcs["ms"] = plural_lo
+	plural_ordinals["ms"] = true
+	plural_funcs["vi"] = plural_lo
+	plural_ordinals["vi"] = true
+	plural_funcs["lt"] = plural_lt
+	plural_ordinals["lt"] = true
+	plural_funcs["lv"] = plural_lv
+	plural_ordinals["lv"] = true
+	plural_funcs["prg"] = plural_lv
+	plural_ordinals["prg"] = true
+	plural_funcs["mk"] = plural_mk
+	plural_ordinals["mk"] = true
+	plural_funcs["mo"] = plural_mo
+	plural_ordinals["mo"] = true
+	plural_funcs["ro"] = plural_mo
+	plural_ordinals["ro"] = true
+	plural_funcs["mr"] = plural_mr
+	plural_ordinals["mr"] = true
+	plural_funcs["mt"] = plural_mt
+	plural_funcs["ne"] = plural_ne
+	plural_ordinals["ne"] = true
+	plural_funcs["pa"] = plural_pa
+	plural_ordinals["pa"] = true
+	plural_funcs["pl"] = plural_pl
+	plural_ordinals["pl"] = true
+	plural_funcs["pt"] = plural_pt
+	plural_ordinals["pt"] = true
+	plural_funcs["pt-PT"] = plural_ptPT
+	plural_funcs["ru"] = plural_ru
+	plural_ordinals["ru"] = true
+	plural_funcs["shi"] = plural_shi
+	plural_funcs["si"] = plural_si
+	plural_ordinals["si"] = true
+	plural_funcs["sl"] = plural_sl
+	plural_ordinals["sl"] = true
+	plural_funcs["sq"] = plural_sq
+	plural_ordinals["sq"] = true
+	plural_funcs["sv"] = plural_sv
+	plural_ordinals["sv"] = true
+	plural_funcs["tzm"] = plural_tzm
+	plural_funcs["uk"] = plural_uk
+	plural_ordinals["uk"] = true
+}
 
-		case n10 == 0, n100 == 11, n100 == 12, n100 == 13, n100 == 14, n100 == 15, n100 == 16, n100 == 17, n100 == 18, n100 == 19, v == 2 && (f100 == 11 || f100 == 12 || f100 == 13 || f100 == 14 || f100 == 15 || f100 == 16 || f100 == 17 || f100 == 18 || f100 == 19):
-			return "zero"
+// af, bg, ce, el, es, eu, ky, ml, mn, nb, ta, te, tr, uz
+func plural_af(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
-		case n10 == 1 && n100 != 11, v == 2 && f10 == 1 && f100 != 11, v != 2 && f10 == 1:
-			return "one"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["mas"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1:
-			return "one"
-		}
+	case n == 1:
+		return One
 	}
+}
 
-	plural_funcs["mg"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+// ak, bh, guw, ln, mg, nso, ti, wa
+func plural_ak(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
-		case n == 0, n == 1:
-			return "one"
-		}
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["mgo"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1:
-			return "one"
-		}
+	case n == 0, n == 1:
+		return One
 	}
+}
 
-	plural_funcs["mk"] = func(value interface{}, ordinal bool) string {
-		f, i, _, v, _, _ := finvtw(value)
-		i10 := i % 10
-		i100 := i % 100
-		f10 := f % 10
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case i10 == 1 && i100 != 11:
-				return "one"
-
-			case i10 == 2 && i100 != 12:
-				return "two"
-
-			case (i10 == 7 || i10 == 8) && i100 != 17 && i100 != 18:
-				return "many"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
+// am, fa, kn, zu
+func plural_am(ops Operands, ordinal bool) Category {
+	n, i := ops.n(), ops.I
 
-		case v == 0 && i10 == 1, f10 == 1:
-			return "one"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["ml"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1:
-			return "one"
-		}
+	case i == 0, n == 1:
+		return One
 	}
+}
 
-	plural_funcs["mn"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
+// ar
+func plural_ar(ops Operands, ordinal bool) Category {
+	n := ops.n()
+	n100 := mod(n, 100)
 
-		case n == 1:
-			return "one"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["mo"] = func(value interface{}, ordinal bool) string {
-		_, i, n, v, _, _ := finvtw(value)
-		n100 := mod(n, 100)
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			switch {
-			default:
-				return "other"
+	case n == 0:
+		return Zero
 
-			case n == 1:
-				return "one"
-			}
-		}
+	case n == 1:
+		return One
 
-		switch {
-		default:
-			return "other"
+	case n == 2:
+		return Two
 
-		case i == 1 && v == 0:
-			return "one"
+	case n100 >= 3 && n100 <= 10:
+		return Few
 
-		case v != 0, n == 0, n != 1 && (n100 == 1 || n100 == 2 || n100 == 3 || n100 == 4 || n100 == 5 || n100 == 6 || n100 == 7 || n100 == 8 || n100 == 9 || n100 == 10 || n100 == 11 || n100 == 12 || n100 == 13 || n100 == 14 || n100 == 15 || n100 == 16 || n100 == 17 || n100 == 18 || n100 == 19):
-			return "few"
-		}
+	case n100 >= 11 && n100 <= 99:
+		return Many
 	}
+}
 
-	plural_funcs["mr"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1:
-				return "one"
-
-			case n == 2, n == 3:
-				return "two"
-
-			case n == 4:
-				return "few"
-			}
-		}
+// as, bn
+func plural_as(ops Operands, ordinal bool) Category {
+	n, i := ops.n(), ops.I
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case i == 0, n == 1:
-			return "one"
-		}
-	}
+		case n == 1, n == 5, n >= 7 && n <= 10:
+			return One
 
-	plural_funcs["ms"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+		case n == 2, n == 3:
+			return Two
 
-		if ordinal {
-			switch {
-			default:
-				return "other"
+		case n == 4:
+			return Few
 
-			case n == 1:
-				return "one"
-			}
+		case n == 6:
+			return Many
 		}
-
-		return "other"
 	}
 
-	plural_funcs["mt"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-		n100 := mod(n, 100)
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-
-		case n == 0, n100 == 2, n100 == 3, n100 == 4, n100 == 5, n100 == 6, n100 == 7, n100 == 8, n100 == 9, n100 == 10:
-			return "few"
+	switch {
+	default:
+		return Other
 
-		case n100 == 11, n100 == 12, n100 == 13, n100 == 14, n100 == 15, n100 == 16, n100 == 17, n100 == 18, n100 == 19:
-			return "many"
-		}
+	case i == 0, n == 1:
+		return One
 	}
+}
 
-	plural_funcs["my"] = func(value interface{}, ordinal bool) string {
-		if ordinal {
-			return "other"
-		}
+// asa, bem, bez, brx, cgg, chr, ckb, dv, ee, eo, fo, fur, gsw, ha, haw, jgo,
+// jmc, kaj, kcg, kkj, kl, ks, ksb, ku, lb, lg, mas, mgo, nah, nd, nn, nnh, no,
+// nr, ny, nyn, om, or, os, pap, ps, rm, rof, rwk, saq, seh, sn, so, ss, ssy,
+// st, syr, teo, tig, tk, tn, ts, ug, ve, vo, vun, wae, xh, xog
+func plural_asa(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
-		return "other"
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["nah"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
+	case n == 1:
+		return One
 	}
+}
 
-	plural_funcs["naq"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// ast, ji, yi
+func plural_ast(ops Operands, ordinal bool) Category {
+	i, v := ops.I, ops.V
 
-		switch {
-		default:
-			return "other"
+	if ordinal {
+		return NoOrdinal
+	}
 
-		case n == 1:
-			return "one"
+	switch {
+	default:
+		return Other
 
-		case n == 2:
-			return "two"
-		}
+	case i == 1 && v == 0:
+		return One
 	}
+}
 
-	plural_funcs["nb"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
+// az
+func plural_az(ops Operands, ordinal bool) Category {
+	n, i := ops.n(), ops.I
+	i10 := i % 10
+	i100 := i % 100
+	i1000 := i % 1000
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
+			return Other
 
-	plural_funcs["nd"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+		case i10 == 1, i10 == 2, i10 == 5, i10 == 7, i10 == 8, i100 == 20, i100 == 50, i100 == 70, i100 == 80:
+			return One
 
-		switch {
-		default:
-			return "other"
+		case i10 == 3, i10 == 4, i1000 == 100, i1000 == 200, i1000 == 300, i1000 == 400, i1000 == 500, i1000 == 600, i1000 == 700, i1000 == 800, i1000 == 900:
+			return Few
 
-		case n == 1:
-			return "one"
+		case i == 0, i10 == 6, i100 == 40, i100 == 60, i100 == 90:
+			return Many
 		}
 	}
 
-	plural_funcs["ne"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			switch {
-			default:
-				return "other"
-
-			case n == 1, n == 2, n == 3, n == 4:
-				return "one"
-			}
-		}
+	case n == 1:
+		return One
+	}
+}
 
-		switch {
-		default:
-			return "other"
+// be
+func plural_be(ops Operands, ordinal bool) Category {
+	n := ops.n()
+	n10 := mod(n, 10)
+	n100 := mod(n, 100)
 
-		case n == 1:
-			return "one"
-		}
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["nl"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			return "other"
-		}
+	case n10 == 1 && n100 != 11:
+		return One
 
-		switch {
-		default:
-			return "other"
+	case n10 >= 2 && n10 <= 4 && (n100 < 12 || n100 > 14):
+		return Few
 
-		case i == 1 && v == 0:
-			return "one"
-		}
+	case n10 == 0, n10 >= 5 && n10 <= 9, n100 >= 11 && n100 <= 14:
+		return Many
 	}
+}
 
-	plural_funcs["nn"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
+// bm, bo, dz, ig, ii, jbo, jv, jw, kde, kea, lkt, nqo, sah, ses, sg, to, wo,
+// yo
+func plural_bm(ops Operands, ordinal bool) Category {
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["nnh"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	return Other
+}
 
-		switch {
-		default:
-			return "other"
+// br
+func plural_br(ops Operands, ordinal bool) Category {
+	n := ops.n()
+	n10 := mod(n, 10)
+	n100 := mod(n, 100)
+	n1000000 := mod(n, 1000000)
 
-		case n == 1:
-			return "one"
-		}
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["no"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
+	switch {
+	default:
+		return Other
 
-	plural_funcs["nqo"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
+	case n10 == 1 && n100 != 11 && n100 != 71 && n100 != 91:
+		return One
 
-	plural_funcs["nr"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	case n10 == 2 && n100 != 12 && n100 != 72 && n100 != 92:
+		return Two
 
-		switch {
-		default:
-			return "other"
+	case (n10 == 3 || n10 == 4 || n10 == 9) && (n100 < 10 || n100 > 19) && (n100 < 70 || n100 > 79) && (n100 < 90 || n100 > 99):
+		return Few
 
-		case n == 1:
-			return "one"
-		}
+	case n != 0 && n1000000 == 0:
+		return Many
 	}
+}
 
-	plural_funcs["nso"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+// bs, hr, sh, sr
+func plural_bs(ops Operands, ordinal bool) Category {
+	i, v, f := ops.I, ops.V, ops.F
+	i10 := i % 10
+	i100 := i % 100
+	f10 := f % 10
+	f100 := f % 100
 
-		case n == 0, n == 1:
-			return "one"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["ny"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case v == 0 && i10 == 1 && i100 != 11, f10 == 1 && f100 != 11:
+		return One
 
-		case n == 1:
-			return "one"
-		}
+	case v == 0 && i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14), f10 >= 2 && f10 <= 4 && (f100 < 12 || f100 > 14):
+		return Few
 	}
+}
 
-	plural_funcs["nyn"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// ca
+func plural_ca(ops Operands, ordinal bool) Category {
+	n, i, v := ops.n(), ops.I, ops.V
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
+			return Other
 
-	plural_funcs["om"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+		case n == 1, n == 3:
+			return One
 
-		switch {
-		default:
-			return "other"
+		case n == 2:
+			return Two
 
-		case n == 1:
-			return "one"
+		case n == 4:
+			return Few
 		}
 	}
 
-	plural_funcs["or"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1:
-			return "one"
-		}
+	case i == 1 && v == 0:
+		return One
 	}
+}
 
-	plural_funcs["os"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+// cs, sk
+func plural_cs(ops Operands, ordinal bool) Category {
+	i, v := ops.I, ops.V
 
-		case n == 1:
-			return "one"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["pa"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			return "other"
-		}
+	case i == 1 && v == 0:
+		return One
 
-		switch {
-		default:
-			return "other"
+	case i >= 2 && i <= 4 && v == 0:
+		return Few
 
-		case n == 0, n == 1:
-			return "one"
-		}
+	case v != 0:
+		return Many
 	}
+}
 
-	plural_funcs["pap"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// cy
+func plural_cy(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
-
-	plural_funcs["pl"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-		i10 := i % 10
-		i100 := i % 100
+			return Other
 
-		if ordinal {
-			return "other"
-		}
+		case n == 0, n >= 7 && n <= 9:
+			return Zero
 
-		switch {
-		default:
-			return "other"
+		case n == 1:
+			return One
 
-		case i == 1 && v == 0:
-			return "one"
+		case n == 2:
+			return Two
 
-		case v == 0 && (i10 == 2 || i10 == 3 || i10 == 4) && i100 != 12 && i100 != 13 && i100 != 14:
-			return "few"
+		case n == 3, n == 4:
+			return Few
 
-		case v == 0 && i != 1 && (i10 == 0 || i10 == 1), v == 0 && (i10 == 5 || i10 == 6 || i10 == 7 || i10 == 8 || i10 == 9), v == 0 && (i100 == 12 || i100 == 13 || i100 == 14):
-			return "many"
+		case n == 5, n == 6:
+			return Many
 		}
 	}
 
-	plural_funcs["prg"] = func(value interface{}, ordinal bool) string {
-		f, _, n, v, _, _ := finvtw(value)
-		n10 := mod(n, 10)
-		n100 := mod(n, 100)
-		f100 := f % 100
-		f10 := f % 10
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n10 == 0, n100 == 11, n100 == 12, n100 == 13, n100 == 14, n100 == 15, n100 == 16, n100 == 17, n100 == 18, n100 == 19, v == 2 && (f100 == 11 || f100 == 12 || f100 == 13 || f100 == 14 || f100 == 15 || f100 == 16 || f100 == 17 || f100 == 18 || f100 == 19):
-			return "zero"
+	case n == 0:
+		return Zero
 
-		case n10 == 1 && n100 != 11, v == 2 && f10 == 1 && f100 != 11, v != 2 && f10 == 1:
-			return "one"
-		}
-	}
+	case n == 1:
+		return One
 
-	plural_funcs["ps"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	case n == 2:
+		return Two
 
-		switch {
-		default:
-			return "other"
+	case n == 3:
+		return Few
 
-		case n == 1:
-			return "one"
-		}
+	case n == 6:
+		return Many
 	}
+}
 
-	plural_funcs["pt"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
+// da
+func plural_da(ops Operands, ordinal bool) Category {
+	n, i, t := ops.n(), ops.I, ops.T
 
-		case (n == 0 || n == 1 || n == 2) && n != 2:
-			return "one"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["pt-PT"] = func(value interface{}, ordinal bool) string {
-		_, _, n, v, _, _ := finvtw(value)
-
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1 && v == 0:
-			return "one"
-		}
+	case n == 1, t != 0 && (i == 0 || i == 1):
+		return One
 	}
+}
 
-	plural_funcs["rm"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+// de, et, fi, fy, gl, nl, sw, ur
+func plural_de(ops Operands, ordinal bool) Category {
+	i, v := ops.I, ops.V
 
-		case n == 1:
-			return "one"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["ro"] = func(value interface{}, ordinal bool) string {
-		_, i, n, v, _, _ := finvtw(value)
-		n100 := mod(n, 100)
-
-		if ordinal {
-			switch {
-			default:
-				return "other"
+	switch {
+	default:
+		return Other
 
-			case n == 1:
-				return "one"
-			}
-		}
-
-		switch {
-		default:
-			return "other"
+	case i == 1 && v == 0:
+		return One
+	}
+}
 
-		case i == 1 && v == 0:
-			return "one"
+// dsb, hsb
+func plural_dsb(ops Operands, ordinal bool) Category {
+	i, v, f := ops.I, ops.V, ops.F
+	i100 := i % 100
+	f100 := f % 100
 
-		case v != 0, n == 0, n != 1 && (n100 == 1 || n100 == 2 || n100 == 3 || n100 == 4 || n100 == 5 || n100 == 6 || n100 == 7 || n100 == 8 || n100 == 9 || n100 == 10 || n100 == 11 || n100 == 12 || n100 == 13 || n100 == 14 || n100 == 15 || n100 == 16 || n100 == 17 || n100 == 18 || n100 == 19):
-			return "few"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["rof"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
+	case v == 0 && i100 == 1, f100 == 1:
+		return One
 
-	plural_funcs["root"] = func(value interface{}, ordinal bool) string {
-		if ordinal {
-			return "other"
-		}
+	case v == 0 && i100 == 2, f100 == 2:
+		return Two
 
-		return "other"
+	case v == 0 && (i100 == 3 || i100 == 4), f100 == 3, f100 == 4:
+		return Few
 	}
+}
 
-	plural_funcs["ru"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-		i10 := i % 10
-		i100 := i % 100
-
-		if ordinal {
-			return "other"
-		}
+// en
+func plural_en(ops Operands, ordinal bool) Category {
+	n, i, v := ops.n(), ops.I, ops.V
+	n10 := mod(n, 10)
+	n100 := mod(n, 100)
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case v == 0 && i10 == 1 && i100 != 11:
-			return "one"
+		case n10 == 1 && n100 != 11:
+			return One
 
-		case v == 0 && (i10 == 2 || i10 == 3 || i10 == 4) && i100 != 12 && i100 != 13 && i100 != 14:
-			return "few"
+		case n10 == 2 && n100 != 12:
+			return Two
 
-		case v == 0 && i10 == 0, v == 0 && (i10 == 5 || i10 == 6 || i10 == 7 || i10 == 8 || i10 == 9), v == 0 && (i100 == 11 || i100 == 12 || i100 == 13 || i100 == 14):
-			return "many"
+		case n10 == 3 && n100 != 13:
+			return Few
 		}
 	}
 
-	plural_funcs["rwk"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
+	switch {
+	default:
+		return Other
 
-	plural_funcs["sah"] = func(value interface{}, ordinal bool) string {
-		return "other"
+	case i == 1 && v == 0:
+		return One
 	}
+}
 
-	plural_funcs["saq"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+// ff, kab
+func plural_ff(ops Operands, ordinal bool) Category {
+	i := ops.I
 
-		case n == 1:
-			return "one"
-		}
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["se"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-
-		case n == 2:
-			return "two"
-		}
+	case i == 0, i == 1:
+		return One
 	}
+}
 
-	plural_funcs["seh"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// fil, tl
+func plural_fil(ops Operands, ordinal bool) Category {
+	n, i, v, f := ops.n(), ops.I, ops.V, ops.F
+	i10 := i % 10
+	f10 := f % 10
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
 		case n == 1:
-			return "one"
+			return One
 		}
 	}
 
-	plural_funcs["ses"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
+	switch {
+	default:
+		return Other
 
-	plural_funcs["sg"] = func(value interface{}, ordinal bool) string {
-		return "other"
+	case v == 0 && i >= 1 && i <= 3, v == 0 && i10 != 4 && i10 != 6 && i10 != 9, v != 0 && f10 != 4 && f10 != 6 && f10 != 9:
+		return One
 	}
+}
 
-	plural_funcs["sh"] = func(value interface{}, ordinal bool) string {
-		f, i, _, v, _, _ := finvtw(value)
-		i10 := i % 10
-		i100 := i % 100
-		f10 := f % 10
-		f100 := f % 100
-
-		if ordinal {
-			return "other"
-		}
+// fr, hy
+func plural_fr(ops Operands, ordinal bool) Category {
+	n, i := ops.n(), ops.I
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case v == 0 && i10 == 1 && i100 != 11, f10 == 1 && f100 != 11:
-			return "one"
-
-		case v == 0 && (i10 == 2 || i10 == 3 || i10 == 4) && i100 != 12 && i100 != 13 && i100 != 14, (f10 == 2 || f10 == 3 || f10 == 4) && f100 != 12 && f100 != 13 && f100 != 14:
-			return "few"
+		case n == 1:
+			return One
 		}
 	}
 
-	plural_funcs["shi"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
-
-		switch {
-		default:
-			return "other"
-
-		case i == 0, n == 1:
-			return "one"
+	switch {
+	default:
+		return Other
 
-		case n == 2, n == 3, n == 4, n == 5, n == 6, n == 7, n == 8, n == 9, n == 10:
-			return "few"
-		}
+	case i == 0, i == 1:
+		return One
 	}
+}
 
-	plural_funcs["si"] = func(value interface{}, ordinal bool) string {
-		f, i, n, _, _, _ := finvtw(value)
-
-		if ordinal {
-			return "other"
-		}
-
-		switch {
-		default:
-			return "other"
+// ga
+func plural_ga(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
-		case n == 0, n == 1, i == 0 && f == 1:
-			return "one"
-		}
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["sk"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-
-		if ordinal {
-			return "other"
-		}
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case n == 1:
+		return One
 
-		case i == 1 && v == 0:
-			return "one"
+	case n == 2:
+		return Two
 
-		case (i == 2 || i == 3 || i == 4) && v == 0:
-			return "few"
+	case n >= 3 && n <= 6:
+		return Few
 
-		case v != 0:
-			return "many"
-		}
+	case n >= 7 && n <= 10:
+		return Many
 	}
+}
 
-	plural_funcs["sl"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
-		i100 := i % 100
+// gd
+func plural_gd(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
-		if ordinal {
-			return "other"
-		}
+	if ordinal {
+		return NoOrdinal
+	}
 
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case v == 0 && i100 == 1:
-			return "one"
+	case n == 1, n == 11:
+		return One
 
-		case v == 0 && i100 == 2:
-			return "two"
+	case n == 2, n == 12:
+		return Two
 
-		case v == 0 && (i100 == 3 || i100 == 4), v != 0:
-			return "few"
-		}
+	case n >= 3 && n <= 10, n >= 13 && n <= 19:
+		return Few
 	}
+}
 
-	plural_funcs["sma"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// gu, hi
+func plural_gu(ops Operands, ordinal bool) Category {
+	n, i := ops.n(), ops.I
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
 		case n == 1:
-			return "one"
+			return One
 
-		case n == 2:
-			return "two"
+		case n == 2, n == 3:
+			return Two
+
+		case n == 4:
+			return Few
+
+		case n == 6:
+			return Many
 		}
 	}
 
-	plural_funcs["smi"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case i == 0, n == 1:
+		return One
+	}
+}
 
-		case n == 1:
-			return "one"
+// gv
+func plural_gv(ops Operands, ordinal bool) Category {
+	i, v := ops.I, ops.V
+	i10 := i % 10
+	i100 := i % 100
 
-		case n == 2:
-			return "two"
-		}
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["smj"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1:
-			return "one"
+	case v == 0 && i10 == 1:
+		return One
 
-		case n == 2:
-			return "two"
-		}
-	}
+	case v == 0 && i10 == 2:
+		return Two
 
-	plural_funcs["smn"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	case v == 0 && (i100 == 0 || i100 == 20 || i100 == 40 || i100 == 60 || i100 == 80):
+		return Few
 
-		switch {
-		default:
-			return "other"
+	case v != 0:
+		return Many
+	}
+}
 
-		case n == 1:
-			return "one"
+// he, iw
+func plural_he(ops Operands, ordinal bool) Category {
+	n, i, v := ops.n(), ops.I, ops.V
+	n10 := mod(n, 10)
 
-		case n == 2:
-			return "two"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["sms"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case i == 1 && v == 0:
+		return One
 
-		case n == 1:
-			return "one"
+	case i == 2 && v == 0:
+		return Two
 
-		case n == 2:
-			return "two"
-		}
+	case v == 0 && n > 10 && n10 == 0:
+		return Many
 	}
+}
 
-	plural_funcs["sn"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// hu
+func plural_hu(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case n == 1:
-			return "one"
+		case n == 1, n == 5:
+			return One
 		}
 	}
 
-	plural_funcs["so"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1:
-			return "one"
-		}
+	case n == 1:
+		return One
 	}
+}
 
-	plural_funcs["sq"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-		n10 := mod(n, 10)
-		n100 := mod(n, 100)
+// id, in, ja, km, ko, my, root, th, zh
+func plural_id(ops Operands, ordinal bool) Category {
+	if ordinal {
+		return Other
+	}
 
-		if ordinal {
-			switch {
-			default:
-				return "other"
+	return Other
+}
 
-			case n == 1:
-				return "one"
+// is
+func plural_is(ops Operands, ordinal bool) Category {
+	i, t := ops.I, ops.T
+	i10 := i % 10
+	i100 := i % 100
 
-			case n10 == 4 && n100 != 14:
-				return "many"
-			}
-		}
+	if ordinal {
+		return Other
+	}
 
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1:
-			return "one"
-		}
+	case t == 0 && i10 == 1 && i100 != 11, t != 0:
+		return One
 	}
+}
 
-	plural_funcs["sr"] = func(value interface{}, ordinal bool) string {
-		f, i, _, v, _, _ := finvtw(value)
-		i10 := i % 10
-		i100 := i % 100
-		f10 := f % 10
-		f100 := f % 100
-
-		if ordinal {
-			return "other"
-		}
+// it
+func plural_it(ops Operands, ordinal bool) Category {
+	n, i, v := ops.n(), ops.I, ops.V
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case v == 0 && i10 == 1 && i100 != 11, f10 == 1 && f100 != 11:
-			return "one"
-
-		case v == 0 && (i10 == 2 || i10 == 3 || i10 == 4) && i100 != 12 && i100 != 13 && i100 != 14, (f10 == 2 || f10 == 3 || f10 == 4) && f100 != 12 && f100 != 13 && f100 != 14:
-			return "few"
+		case n == 11, n == 8, n == 80, n == 800:
+			return Many
 		}
 	}
 
-	plural_funcs["ss"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case i == 1 && v == 0:
+		return One
+	}
+}
 
-		case n == 1:
-			return "one"
-		}
+// iu, kw, naq, se, sma, smi, smj, smn, sms
+func plural_iu(ops Operands, ordinal bool) Category {
+	n := ops.n()
+
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["ssy"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case n == 1:
+		return One
 
-		case n == 1:
-			return "one"
-		}
+	case n == 2:
+		return Two
 	}
+}
 
-	plural_funcs["st"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// ka
+func plural_ka(ops Operands, ordinal bool) Category {
+	n, i := ops.n(), ops.I
+	i100 := i % 100
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case n == 1:
-			return "one"
+		case i == 1:
+			return One
+
+		case i == 0, i100 >= 2 && i100 <= 20, i100 == 40, i100 == 60, i100 == 80:
+			return Many
 		}
 	}
 
-	plural_funcs["sv"] = func(value interface{}, ordinal bool) string {
-		_, i, n, v, _, _ := finvtw(value)
-		n10 := mod(n, 10)
-		n100 := mod(n, 100)
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			switch {
-			default:
-				return "other"
+	case n == 1:
+		return One
+	}
+}
 
-			case (n10 == 1 || n10 == 2) && n100 != 11 && n100 != 12:
-				return "one"
-			}
-		}
+// kk
+func plural_kk(ops Operands, ordinal bool) Category {
+	n := ops.n()
+	n10 := mod(n, 10)
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case i == 1 && v == 0:
-			return "one"
+		case n10 == 6, n10 == 9, n10 == 0 && n != 0:
+			return Many
 		}
 	}
 
-	plural_funcs["sw"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			return "other"
-		}
+	case n == 1:
+		return One
+	}
+}
 
-		switch {
-		default:
-			return "other"
+// ksh
+func plural_ksh(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
-		case i == 1 && v == 0:
-			return "one"
-		}
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["syr"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case n == 0:
+		return Zero
 
-		case n == 1:
-			return "one"
-		}
+	case n == 1:
+		return One
 	}
+}
 
-	plural_funcs["ta"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// lag
+func plural_lag(ops Operands, ordinal bool) Category {
+	n, i := ops.n(), ops.I
 
-		if ordinal {
-			return "other"
-		}
+	if ordinal {
+		return NoOrdinal
+	}
 
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1:
-			return "one"
-		}
-	}
+	case n == 0:
+		return Zero
 
-	plural_funcs["te"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	case (i == 0 || i == 1) && n != 0:
+		return One
+	}
+}
 
-		if ordinal {
-			return "other"
-		}
+// lo, ms, vi
+func plural_lo(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
 		case n == 1:
-			return "one"
+			return One
 		}
 	}
 
-	plural_funcs["teo"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	return Other
+}
 
-		switch {
-		default:
-			return "other"
+// lt
+func plural_lt(ops Operands, ordinal bool) Category {
+	n, f := ops.n(), ops.F
+	n10 := mod(n, 10)
+	n100 := mod(n, 100)
 
-		case n == 1:
-			return "one"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["th"] = func(value interface{}, ordinal bool) string {
-		if ordinal {
-			return "other"
-		}
+	switch {
+	default:
+		return Other
 
-		return "other"
-	}
+	case n10 == 1 && (n100 < 11 || n100 > 19):
+		return One
 
-	plural_funcs["ti"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	case n10 >= 2 && n10 <= 9 && (n100 < 11 || n100 > 19):
+		return Few
 
-		switch {
-		default:
-			return "other"
+	case f != 0:
+		return Many
+	}
+}
 
-		case n == 0, n == 1:
-			return "one"
-		}
+// lv, prg
+func plural_lv(ops Operands, ordinal bool) Category {
+	n, v, f := ops.n(), ops.V, ops.F
+	n10 := mod(n, 10)
+	n100 := mod(n, 100)
+	f100 := f % 100
+	f10 := f % 10
+
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["tig"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case n10 == 0, n100 >= 11 && n100 <= 19, v == 2 && f100 >= 11 && f100 <= 19:
+		return Zero
 
-		case n == 1:
-			return "one"
-		}
+	case n10 == 1 && n100 != 11, v == 2 && f10 == 1 && f100 != 11, v != 2 && f10 == 1:
+		return One
 	}
+}
 
-	plural_funcs["tk"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// mk
+func plural_mk(ops Operands, ordinal bool) Category {
+	i, v, f := ops.I, ops.V, ops.F
+	i10 := i % 10
+	i100 := i % 100
+	f10 := f % 10
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
-
-		case n == 1:
-			return "one"
-		}
-	}
+			return Other
 
-	plural_funcs["tl"] = func(value interface{}, ordinal bool) string {
-		f, i, n, v, _, _ := finvtw(value)
-		i10 := i % 10
-		f10 := f % 10
+		case i10 == 1 && i100 != 11:
+			return One
 
-		if ordinal {
-			switch {
-			default:
-				return "other"
+		case i10 == 2 && i100 != 12:
+			return Two
 
-			case n == 1:
-				return "one"
-			}
+		case (i10 == 7 || i10 == 8) && i100 != 17 && i100 != 18:
+			return Many
 		}
+	}
 
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case v == 0 && (i == 1 || i == 2 || i == 3), v == 0 && i10 != 4 && i10 != 6 && i10 != 9, v != 0 && f10 != 4 && f10 != 6 && f10 != 9:
-			return "one"
-		}
+	case v == 0 && i10 == 1, f10 == 1:
+		return One
 	}
+}
 
-	plural_funcs["tn"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// mo, ro
+func plural_mo(ops Operands, ordinal bool) Category {
+	n, i, v := ops.n(), ops.I, ops.V
+	n100 := mod(n, 100)
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
 		case n == 1:
-			return "one"
+			return One
 		}
 	}
 
-	plural_funcs["to"] = func(value interface{}, ordinal bool) string {
-		return "other"
-	}
+	switch {
+	default:
+		return Other
 
-	plural_funcs["tr"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	case i == 1 && v == 0:
+		return One
 
-		if ordinal {
-			return "other"
-		}
+	case v != 0, n == 0, n != 1 && n100 >= 1 && n100 <= 19:
+		return Few
+	}
+}
 
+// mr
+func plural_mr(ops Operands, ordinal bool) Category {
+	n, i := ops.n(), ops.I
+
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
 		case n == 1:
-			return "one"
+			return One
+
+		case n == 2, n == 3:
+			return Two
+
+		case n == 4:
+			return Few
 		}
 	}
 
-	plural_funcs["ts"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case i == 0, n == 1:
+		return One
+	}
+}
 
-		case n == 1:
-			return "one"
-		}
+// mt
+func plural_mt(ops Operands, ordinal bool) Category {
+	n := ops.n()
+	n100 := mod(n, 100)
+
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["tzm"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case n == 1:
+		return One
 
-		case n == 0, n == 1, n == 11, n == 12, n == 13, n == 14, n == 15, n == 16, n == 17, n == 18, n == 19, n == 20, n == 21, n == 22, n == 23, n == 24, n == 25, n == 26, n == 27, n == 28, n == 29, n == 30, n == 31, n == 32, n == 33, n == 34, n == 35, n == 36, n == 37, n == 38, n == 39, n == 40, n == 41, n == 42, n == 43, n == 44, n == 45, n == 46, n == 47, n == 48, n == 49, n == 50, n == 51, n == 52, n == 53, n == 54, n == 55, n == 56, n == 57, n == 58, n == 59, n == 60, n == 61, n == 62, n == 63, n == 64, n == 65, n == 66, n == 67, n == 68, n == 69, n == 70, n == 71, n == 72, n == 73, n == 74, n == 75, n == 76, n == 77, n == 78, n == 79, n == 80, n == 81, n == 82, n == 83, n == 84, n == 85, n == 86, n == 87, n == 88, n == 89, n == 90, n == 91, n == 92, n == 93, n == 94, n == 95, n == 96, n == 97, n == 98, n == 99:
-			return "one"
-		}
+	case n == 0, n100 >= 2 && n100 <= 10:
+		return Few
+
+	case n100 >= 11 && n100 <= 19:
+		return Many
 	}
+}
 
-	plural_funcs["ug"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// ne
+func plural_ne(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case n == 1:
-			return "one"
+		case n >= 1 && n <= 4:
+			return One
 		}
 	}
 
-	plural_funcs["uk"] = func(value interface{}, ordinal bool) string {
-		_, i, n, v, _, _ := finvtw(value)
-		n10 := mod(n, 10)
-		n100 := mod(n, 100)
-		i10 := i % 10
-		i100 := i % 100
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			switch {
-			default:
-				return "other"
+	case n == 1:
+		return One
+	}
+}
 
-			case n10 == 3 && n100 != 13:
-				return "few"
-			}
-		}
+// pa
+func plural_pa(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
-		switch {
-		default:
-			return "other"
+	if ordinal {
+		return Other
+	}
+
+	switch {
+	default:
+		return Other
 
-		case v == 0 && i10 == 1 && i100 != 11:
-			return "one"
+	case n == 0, n == 1:
+		return One
+	}
+}
 
-		case v == 0 && (i10 == 2 || i10 == 3 || i10 == 4) && i100 != 12 && i100 != 13 && i100 != 14:
-			return "few"
+// pl
+func plural_pl(ops Operands, ordinal bool) Category {
+	i, v := ops.I, ops.V
+	i10 := i % 10
+	i100 := i % 100
 
-		case v == 0 && i10 == 0, v == 0 && (i10 == 5 || i10 == 6 || i10 == 7 || i10 == 8 || i10 == 9), v == 0 && (i100 == 11 || i100 == 12 || i100 == 13 || i100 == 14):
-			return "many"
-		}
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["ur"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			return "other"
-		}
+	case i == 1 && v == 0:
+		return One
 
-		switch {
-		default:
-			return "other"
+	case v == 0 && i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14):
+		return Few
 
-		case i == 1 && v == 0:
-			return "one"
-		}
+	case v == 0 && i != 1 && (i10 == 0 || i10 == 1), v == 0 && i10 >= 5 && i10 <= 9, v == 0 && i100 >= 12 && i100 <= 14:
+		return Many
 	}
+}
 
-	plural_funcs["uz"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// pt
+func plural_pt(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
-		if ordinal {
-			return "other"
-		}
+	if ordinal {
+		return Other
+	}
 
-		switch {
-		default:
-			return "other"
+	switch {
+	default:
+		return Other
 
-		case n == 1:
-			return "one"
-		}
+	case n <= 2 && n != 2:
+		return One
 	}
+}
 
-	plural_funcs["ve"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
-
-		switch {
-		default:
-			return "other"
+// pt-PT
+func plural_ptPT(ops Operands, ordinal bool) Category {
+	n, v := ops.n(), ops.V
 
-		case n == 1:
-			return "one"
-		}
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["vi"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			switch {
-			default:
-				return "other"
+	case n == 1 && v == 0:
+		return One
+	}
+}
 
-			case n == 1:
-				return "one"
-			}
-		}
+// ru
+func plural_ru(ops Operands, ordinal bool) Category {
+	i, v := ops.I, ops.V
+	i10 := i % 10
+	i100 := i % 100
 
-		return "other"
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["vo"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case v == 0 && i10 == 1 && i100 != 11:
+		return One
 
-		case n == 1:
-			return "one"
-		}
-	}
+	case v == 0 && i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14):
+		return Few
 
-	plural_funcs["vun"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	case v == 0 && i10 == 0, v == 0 && i10 >= 5 && i10 <= 9, v == 0 && i100 >= 11 && i100 <= 14:
+		return Many
+	}
+}
 
-		switch {
-		default:
-			return "other"
+// shi
+func plural_shi(ops Operands, ordinal bool) Category {
+	n, i := ops.n(), ops.I
 
-		case n == 1:
-			return "one"
-		}
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["wa"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case i == 0, n == 1:
+		return One
 
-		case n == 0, n == 1:
-			return "one"
-		}
+	case n >= 2 && n <= 10:
+		return Few
 	}
+}
 
-	plural_funcs["wae"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// si
+func plural_si(ops Operands, ordinal bool) Category {
+	n, i, f := ops.n(), ops.I, ops.F
 
-		switch {
-		default:
-			return "other"
+	if ordinal {
+		return Other
+	}
 
-		case n == 1:
-			return "one"
-		}
+	switch {
+	default:
+		return Other
+
+	case n == 0, n == 1, i == 0 && f == 1:
+		return One
 	}
+}
 
-	plural_funcs["wo"] = func(value interface{}, ordinal bool) string {
-		return "other"
+// sl
+func plural_sl(ops Operands, ordinal bool) Category {
+	i, v := ops.I, ops.V
+	i100 := i % 100
+
+	if ordinal {
+		return Other
 	}
 
-	plural_funcs["xh"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+	switch {
+	default:
+		return Other
 
-		switch {
-		default:
-			return "other"
+	case v == 0 && i100 == 1:
+		return One
 
-		case n == 1:
-			return "one"
-		}
+	case v == 0 && i100 == 2:
+		return Two
+
+	case v == 0 && (i100 == 3 || i100 == 4), v != 0:
+		return Few
 	}
+}
 
-	plural_funcs["xog"] = func(value interface{}, ordinal bool) string {
-		n := math.Abs(float(value))
+// sq
+func plural_sq(ops Operands, ordinal bool) Category {
+	n := ops.n()
+	n10 := mod(n, 10)
+	n100 := mod(n, 100)
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
 		case n == 1:
-			return "one"
+			return One
+
+		case n10 == 4 && n100 != 14:
+			return Many
 		}
 	}
 
-	plural_funcs["yi"] = func(value interface{}, ordinal bool) string {
-		_, i, _, v, _, _ := finvtw(value)
+	switch {
+	default:
+		return Other
+
+	case n == 1:
+		return One
+	}
+}
+
+// sv
+func plural_sv(ops Operands, ordinal bool) Category {
+	n, i, v := ops.n(), ops.I, ops.V
+	n10 := mod(n, 10)
+	n100 := mod(n, 100)
 
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case i == 1 && v == 0:
-			return "one"
+		case (n10 == 1 || n10 == 2) && n100 != 11 && n100 != 12:
+			return One
 		}
 	}
 
-	plural_funcs["yo"] = func(value interface{}, ordinal bool) string {
-		return "other"
+	switch {
+	default:
+		return Other
+
+	case i == 1 && v == 0:
+		return One
 	}
+}
 
-	plural_funcs["zh"] = func(value interface{}, ordinal bool) string {
-		if ordinal {
-			return "other"
-		}
+// tzm
+func plural_tzm(ops Operands, ordinal bool) Category {
+	n := ops.n()
 
-		return "other"
+	if ordinal {
+		return NoOrdinal
 	}
 
-	plural_funcs["zu"] = func(value interface{}, ordinal bool) string {
-		flt := float(value)
-		n := math.Abs(flt)
-		i := int64(flt)
+	switch {
+	default:
+		return Other
 
-		if ordinal {
-			return "other"
-		}
+	case n == 0, n == 1, n >= 11 && n <= 99:
+		return One
+	}
+}
 
+// uk
+func plural_uk(ops Operands, ordinal bool) Category {
+	n, i, v := ops.n(), ops.I, ops.V
+	n10 := mod(n, 10)
+	n100 := mod(n, 100)
+	i10 := i % 10
+	i100 := i % 100
+
+	if ordinal {
 		switch {
 		default:
-			return "other"
+			return Other
 
-		case i == 0, n == 1:
-			return "one"
+		case n10 == 3 && n100 != 13:
+			return Few
 		}
 	}
+
+	switch {
+	default:
+		return Other
+
+	case v == 0 && i10 == 1 && i100 != 11:
+		return One
+
+	case v == 0 && i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14):
+		return Few
+
+	case v == 0 && i10 == 0, v == 0 && i10 >= 5 && i10 <= 9, v == 0 && i100 >= 11 && i100 <= 14:
+		return Many
+	}
 }
 
-func GetFunc(name string) (func(interface{}, bool) string, error) {
-	fn, ok := plural_funcs[name]
-	if !ok {
-		return nil, fmt.Errorf("UnknownCulture: `%s`", name)
+// Returns the plural function of a culture, or of its closest parent (see Resolve),
+// for operands computed beforehand (see NewOperands). Ordinal values follow the
+// rules of the closest culture having some, eg. the ones of pt for pt-AO, and
+// are NoOrdinal when there is none (see HasOrdinal).
+func GetOperandsFunc(name string) (OperandsFunc, error) {
+	culture, err := Resolve(name)
+	if nil != err {
+		return nil, err
+	}
+
+	fn := plural_funcs[culture]
+	if ordinal_culture, err := resolveOrdinal(name); nil == err && ordinal_culture != culture {
+		cardinal_fn, ordinal_fn := fn, plural_funcs[ordinal_culture]
+		fn = func(ops Operands, ordinal bool) Category {
+			if ordinal {
+				return ordinal_fn(ops, true)
+			}
+			return cardinal_fn(ops, false)
+		}
 	}
 	return fn, nil
 }
+
+// Returns the plural function of a culture, or of its closest parent (see Resolve).
+// Without ordinal rules for the culture nor for its parents (see HasOrdinal), its
+// ordinal category is NoOrdinal, never the cardinal one.
+func GetCategoryFunc(name string) (Func, error) {
+	fn, err := GetOperandsFunc(name)
+	if nil != err {
+		return nil, err
+	}
+	return func(value interface{}, ordinal bool) Category {
+		// Values which are not numbers (see NewOperands) are handled as 0
+		ops, _ := NewOperands(value)
+		return fn(ops, ordinal)
+	}, nil
+}
+
+// Returns the plural function of a culture, its categories being returned as
+// strings ("one", "few"...). As it always did, it applies the cardinal rules to
+// ordinal values when the culture has no ordinal rules (see HasOrdinal).
+func GetFunc(name string) (func(interface{}, bool) string, error) {
+	fn, err := GetCategoryFunc(name)
+	if nil != err {
+		return nil, err
+	}
+	return func(value interface{}, ordinal bool) string {
+		result := fn(value, ordinal)
+		if NoOrdinal == result {
+			result = fn(value, false)
+		}
+		return result.String()
+	}, nil
+}
@@ -0,0 +1,50 @@
+package plural
+
+import (
+	"sort"
+	"testing"
+)
+
+var bench_values = []interface{}{0, 1, 2, 3, 5, 11, 21, 99, 101, 1000000, 1.5, "0.0", "1.0", "12.30", "103.0"}
+
+// Times the given functions only, the operands of the values being computed
+// beforehand
+func benchmarkFunc(b *testing.B, fns ...OperandsFunc) {
+	var operands []Operands
+	for _, value := range bench_values {
+		ops, err := NewOperands(value)
+		if nil != err {
+			b.Fatal(err)
+		}
+		operands = append(operands, ops)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, fn := range fns {
+			for _, ops := range operands {
+				fn(ops, false)
+				fn(ops, true)
+			}
+		}
+	}
+}
+
+func BenchmarkPluralFuncs(b *testing.B) {
+	var cultures []string
+	for culture := range plural_funcs {
+		cultures = append(cultures, culture)
+	}
+	sort.Strings(cultures)
+
+	var fns []OperandsFunc
+	for _, culture := range cultures {
+		fns = append(fns, plural_funcs[culture])
+	}
+	benchmarkFunc(b, fns...)
+}
+
+func BenchmarkPluralFunc_ar(b *testing.B) {
+	skipWithout(b, "ar")
+	benchmarkFunc(b, plural_funcs["ar"])
+}
@@ -1,13 +1,19 @@
 // Generated by https://github.com/gotnospirit/makeplural
-// at Tue, 09 Jun 2015 18:02:38 +0200
+// at Sat, 17 Oct 2026 04:41:56 +0000
 //
-// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/ordinals.json
+// File: ../cldr-core-27-rebuilt/supplemental/ordinals.json
 // $Revision: 11229 $
 // $Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $
 //
-// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/plurals.json
+// File: ../cldr-core-27-rebuilt/supplemental/plurals.json
 // $Revision: 11229 $
 // $Date: 2015-02-18 09:11:57 -0600 (Wed, 18 Feb 2015) $
+//
+// No parent locales, only falling back by truncation
+//
+// No language aliases, deprecated codes are left as is
+//
+// No plural ranges, a range falls into the category of its end
 
 package plural
 
@@ -16,8 +22,8 @@ import (
 	"testing"
 )
 
-func getPluralFunc(t *testing.T, culture string) func(interface{}, bool) string {
-	result, err := GetFunc(culture)
+func getPluralFunc(t *testing.T, culture string) Func {
+	result, err := GetCategoryFunc(culture)
 	if nil != err {
 		t.Errorf("Unexpected error: %s", err.Error())
 		return nil
@@ -25,15 +31,1394 @@ func getPluralFunc(t *testing.T, culture string) func(interface{}, bool) string
 	return result
 }
 
-func testNamedKey(t *testing.T, fn func(interface{}, bool) string, input interface{}, expected, name string, ordinal bool) {
+func testNamedKey(t *testing.T, fn Func, input interface{}, expected, name string, ordinal bool) {
 	result := fn(input, ordinal)
-	if result != expected {
+	if result.String() != expected {
 		t.Errorf("`%s` expecting <%v> but got <%v>", name, expected, result)
 	} else if testing.Verbose() {
 		fmt.Printf("- Got expected result <%s> for `%v`\n", result, input)
 	}
 }
 
+func testRange(t *testing.T, culture string, start, end interface{}, expected string) {
+	result, err := SelectRange(culture, start, end)
+	if nil != err {
+		t.Errorf("Unexpected error: %s", err.Error())
+	} else if result.String() != expected {
+		t.Errorf("`%s` expecting <%v> for %v~%v but got <%v>", culture, expected, start, end, result)
+	} else if testing.Verbose() {
+		fmt.Printf("- Got expected result <%s> for `%v~%v`\n", result, start, end)
+	}
+}
+
+// Rules of each culture as read from the data named in the header, cardinal
+// then ordinal ones, see TestCompile
+var plural_rules = map[string][2]map[Category]string{
+	"af":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ak":    {{One: "n = 0,1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"am":    {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ar":    {{Zero: "n = 0 @integer 0 @decimal 0.0, 0.00, 0.000, 0.0000", One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Few: "n % 100 = 3..10 @integer 3, 10, 103, 110, 1003 @decimal 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 103.0, 1003.0", Many: "n % 100 = 11..99 @integer 11, 26, 111, 1011 @decimal 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 17.0, 18.0, 111.0, 1011.0", Other: "@integer 100, 102, 200, 202, 300, 302, 400, 402, 500, 502, 600, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"as":    {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1,5,7..10 @integer 1, 5, 7, 10", Two: "n = 2,3 @integer 2, 3", Few: "n = 4 @integer 4", Many: "n = 6 @integer 6", Other: "@integer 0, 11, 25, 100, 1000, 10000, 100000, 1000000"}},
+	"asa":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ast":   {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"az":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "i % 10 = 1,2,5,7,8 or i % 100 = 20,50,70,80 @integer 1, 2, 5, 7, 8, 11, 12, 15, 17, 18, 20, 22, 25, 101, 1001", Few: "i % 10 = 3,4 or i % 1000 = 100,200,300,400,500,600,700,800,900 @integer 3, 4, 13, 14, 23, 24, 33, 34, 43, 44, 53, 54, 63, 64, 73, 74, 100, 1003", Many: "i = 0 or i % 10 = 6 or i % 100 = 40,60,90 @integer 0, 6, 16, 26, 36, 40, 46, 56, 106, 1006", Other: "@integer 9, 10, 19, 29, 30, 39, 49, 59, 69, 79, 109, 1000, 10000, 100000, 1000000"}},
+	"be":    {{One: "n % 10 = 1 and n % 100 != 11 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001 @decimal 1.0, 21.0, 31.0, 41.0, 51.0, 61.0, 71.0, 81.0, 101.0, 1001.0", Few: "n % 10 = 2..4 and n % 100 != 12..14 @integer 2, 4, 22, 24, 32, 34, 42, 44, 52, 54, 62, 102, 1002 @decimal 2.0, 3.0, 4.0, 22.0, 23.0, 24.0, 32.0, 33.0, 102.0, 1002.0", Many: "n % 10 = 0,5..9 or n % 100 = 11..14 @integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 11.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", Other: "@decimal 0.1, 0.9, 1.1, 1.7, 10.1, 100.1, 1000.1"}, nil},
+	"bem":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"bez":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"bg":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"bh":    {{One: "n = 0,1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"bm":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"bn":    {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1,5,7..10 @integer 1, 5, 7, 10", Two: "n = 2,3 @integer 2, 3", Few: "n = 4 @integer 4", Many: "n = 6 @integer 6", Other: "@integer 0, 11, 25, 100, 1000, 10000, 100000, 1000000"}},
+	"bo":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"br":    {{One: "n % 10 = 1 and n % 100 != 11,71,91 @integer 1, 21, 31, 41, 51, 61, 81, 101, 1001 @decimal 1.0, 21.0, 31.0, 41.0, 51.0, 61.0, 81.0, 101.0, 1001.0", Two: "n % 10 = 2 and n % 100 != 12,72,92 @integer 2, 22, 32, 42, 52, 62, 82, 102, 1002 @decimal 2.0, 22.0, 32.0, 42.0, 52.0, 62.0, 82.0, 102.0, 1002.0", Few: "n % 10 = 3,4,9 and n % 100 != 10..19,70..79,90..99 @integer 3, 4, 9, 23, 24, 29, 33, 34, 39, 43, 44, 49, 103, 1003 @decimal 3.0, 4.0, 9.0, 23.0, 24.0, 29.0, 33.0, 34.0, 103.0, 1003.0", Many: "n != 0 and n % 1000000 = 0 @integer 1000000 @decimal 1000000.0, 1000000.00, 1000000.000", Other: "@integer 0, 5, 8, 10, 20, 100, 1000, 10000, 100000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0"}, nil},
+	"brx":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"bs":    {{One: "v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001 @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1", Few: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14 @integer 2, 4, 22, 24, 32, 34, 42, 44, 52, 54, 62, 102, 1002 @decimal 0.2, 0.4, 1.2, 1.4, 2.2, 2.4, 3.2, 3.4, 4.2, 4.4, 5.2, 10.2, 100.2, 1000.2", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.5, 1.0, 1.5, 2.0, 2.5, 2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ca":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1,3 @integer 1, 3", Two: "n = 2 @integer 2", Few: "n = 4 @integer 4", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000"}},
+	"ce":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"cgg":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"chr":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ckb":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"cs":    {{One: "i = 1 and v = 0 @integer 1", Few: "i = 2..4 and v = 0 @integer 2, 4", Many: "v != 0 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"cy":    {{Zero: "n = 0 @integer 0 @decimal 0.0, 0.00, 0.000, 0.0000", One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Few: "n = 3 @integer 3 @decimal 3.0, 3.00, 3.000, 3.0000", Many: "n = 6 @integer 6 @decimal 6.0, 6.00, 6.000, 6.0000", Other: "@integer 4, 5, 7, 20, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Zero: "n = 0,7..9 @integer 0, 7, 9", One: "n = 1 @integer 1", Two: "n = 2 @integer 2", Few: "n = 3,4 @integer 3, 4", Many: "n = 5,6 @integer 5, 6", Other: "@integer 10, 25, 100, 1000, 10000, 100000, 1000000"}},
+	"da":    {{One: "n = 1 or t != 0 and i = 0,1 @integer 1 @decimal 0.1, 1.6", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 2.0, 3.4, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"de":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"dsb":   {{One: "v = 0 and i % 100 = 1 or f % 100 = 1 @integer 1, 101, 201, 301, 401, 501, 601, 701, 1001 @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1", Two: "v = 0 and i % 100 = 2 or f % 100 = 2 @integer 2, 102, 202, 302, 402, 502, 602, 702, 1002 @decimal 0.2, 1.2, 2.2, 3.2, 4.2, 5.2, 6.2, 7.2, 10.2, 100.2, 1000.2", Few: "v = 0 and i % 100 = 3,4 or f % 100 = 3,4 @integer 3, 4, 103, 104, 203, 204, 303, 304, 403, 404, 503, 504, 603, 604, 703, 704, 1003 @decimal 0.3, 0.4, 1.3, 1.4, 2.3, 2.4, 3.3, 3.4, 4.3, 4.4, 5.3, 5.4, 6.3, 6.4, 7.3, 7.4, 10.3, 100.3, 1000.3", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.5, 1.0, 1.5, 2.0, 2.5, 2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"dv":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"dz":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ee":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"el":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"en":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n % 10 = 1 and n % 100 != 11 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001", Two: "n % 10 = 2 and n % 100 != 12 @integer 2, 22, 32, 42, 52, 62, 72, 82, 102, 1002", Few: "n % 10 = 3 and n % 100 != 13 @integer 3, 23, 33, 43, 53, 63, 73, 83, 103, 1003", Other: "@integer 0, 4, 18, 100, 1000, 10000, 100000, 1000000"}},
+	"eo":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"es":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"et":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"eu":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"fa":    {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ff":    {{One: "i = 0,1 @integer 0, 1 @decimal 0.0, 1.5", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 2.0, 3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"fi":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"fil":   {{One: "v = 0 and i = 1..3 or v = 0 and i % 10 != 4,6,9 or v != 0 and f % 10 != 4,6,9 @integer 0, 3, 5, 7, 8, 10, 13, 15, 17, 18, 20, 21, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.3, 0.5, 0.7, 0.8, 1.0, 1.3, 1.5, 1.7, 1.8, 2.0, 2.1, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", Other: "@integer 4, 6, 9, 14, 16, 19, 24, 26, 104, 1004 @decimal 0.4, 0.6, 0.9, 1.4, 1.6, 1.9, 2.4, 2.6, 10.4, 100.4, 1000.4"}, {One: "n = 1 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"fo":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"fr":    {{One: "i = 0,1 @integer 0, 1 @decimal 0.0, 1.5", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 2.0, 3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"fur":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"fy":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ga":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Few: "n = 3..6 @integer 3, 6 @decimal 3.0, 4.0, 5.0, 6.0, 3.00, 4.00, 5.00, 6.00, 3.000, 4.000, 5.000, 6.000, 3.0000, 4.0000, 5.0000, 6.0000", Many: "n = 7..10 @integer 7, 10 @decimal 7.0, 8.0, 9.0, 10.0, 7.00, 8.00, 9.00, 10.00, 7.000, 8.000, 9.000, 10.000, 7.0000, 8.0000, 9.0000, 10.0000", Other: "@integer 0, 11, 25, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"gd":    {{One: "n = 1,11 @integer 1, 11 @decimal 1.0, 11.0, 1.00, 11.00, 1.000, 11.000, 1.0000", Two: "n = 2,12 @integer 2, 12 @decimal 2.0, 12.0, 2.00, 12.00, 2.000, 12.000, 2.0000", Few: "n = 3..10,13..19 @integer 3, 10, 13, 19 @decimal 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 13.0, 14.0, 15.0, 16.0, 17.0, 18.0, 19.0, 3.00", Other: "@integer 0, 20, 34, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"gl":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"gsw":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"gu":    {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1 @integer 1", Two: "n = 2,3 @integer 2, 3", Few: "n = 4 @integer 4", Many: "n = 6 @integer 6", Other: "@integer 0, 5, 7, 20, 100, 1000, 10000, 100000, 1000000"}},
+	"guw":   {{One: "n = 0,1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"gv":    {{One: "v = 0 and i % 10 = 1 @integer 1, 11, 21, 31, 41, 51, 61, 71, 101, 1001", Two: "v = 0 and i % 10 = 2 @integer 2, 12, 22, 32, 42, 52, 62, 72, 102, 1002", Few: "v = 0 and i % 100 = 0,20,40,60,80 @integer 0, 20, 40, 60, 80, 100, 120, 140, 1000, 10000, 100000, 1000000", Many: "v != 0 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", Other: "@integer 3, 10, 13, 19, 23, 103, 1003"}, nil},
+	"ha":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"haw":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"he":    {{One: "i = 1 and v = 0 @integer 1", Two: "i = 2 and v = 0 @integer 2", Many: "v = 0 and n != 0..10 and n % 10 = 0 @integer 20, 30, 40, 50, 60, 70, 80, 90, 100, 1000, 10000, 100000, 1000000", Other: "@integer 0, 3, 17, 101, 1001 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"hi":    {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1 @integer 1", Two: "n = 2,3 @integer 2, 3", Few: "n = 4 @integer 4", Many: "n = 6 @integer 6", Other: "@integer 0, 5, 7, 20, 100, 1000, 10000, 100000, 1000000"}},
+	"hr":    {{One: "v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001 @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1", Few: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14 @integer 2, 4, 22, 24, 32, 34, 42, 44, 52, 54, 62, 102, 1002 @decimal 0.2, 0.4, 1.2, 1.4, 2.2, 2.4, 3.2, 3.4, 4.2, 4.4, 5.2, 10.2, 100.2, 1000.2", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.5, 1.0, 1.5, 2.0, 2.5, 2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"hsb":   {{One: "v = 0 and i % 100 = 1 or f % 100 = 1 @integer 1, 101, 201, 301, 401, 501, 601, 701, 1001 @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1", Two: "v = 0 and i % 100 = 2 or f % 100 = 2 @integer 2, 102, 202, 302, 402, 502, 602, 702, 1002 @decimal 0.2, 1.2, 2.2, 3.2, 4.2, 5.2, 6.2, 7.2, 10.2, 100.2, 1000.2", Few: "v = 0 and i % 100 = 3,4 or f % 100 = 3,4 @integer 3, 4, 103, 104, 203, 204, 303, 304, 403, 404, 503, 504, 603, 604, 703, 704, 1003 @decimal 0.3, 0.4, 1.3, 1.4, 2.3, 2.4, 3.3, 3.4, 4.3, 4.4, 5.3, 5.4, 6.3, 6.4, 7.3, 7.4, 10.3, 100.3, 1000.3", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.5, 1.0, 1.5, 2.0, 2.5, 2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"hu":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1,5 @integer 1, 5", Other: "@integer 0, 2, 4, 6, 17, 100, 1000, 10000, 100000, 1000000"}},
+	"hy":    {{One: "i = 0,1 @integer 0, 1 @decimal 0.0, 1.5", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 2.0, 3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"id":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ig":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ii":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"in":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"is":    {{One: "t = 0 and i % 10 = 1 and i % 100 != 11 or t != 0 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001 @decimal 0.1, 1.6, 10.1, 100.1, 1000.1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"it":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Many: "n = 11,8,80,800 @integer 8, 11, 80, 800", Other: "@integer 0, 7, 9, 10, 12, 17, 100, 1000, 10000, 100000, 1000000"}},
+	"iu":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"iw":    {{One: "i = 1 and v = 0 @integer 1", Two: "i = 2 and v = 0 @integer 2", Many: "v = 0 and n != 0..10 and n % 10 = 0 @integer 20, 30, 40, 50, 60, 70, 80, 90, 100, 1000, 10000, 100000, 1000000", Other: "@integer 0, 3, 17, 101, 1001 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ja":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"jbo":   {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"jgo":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ji":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"jmc":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"jv":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"jw":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ka":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "i = 1 @integer 1", Many: "i = 0 or i % 100 = 2..20,40,60,80 @integer 0, 2, 16, 102, 1002", Other: "@integer 21, 36, 100, 1000, 10000, 100000, 1000000"}},
+	"kab":   {{One: "i = 0,1 @integer 0, 1 @decimal 0.0, 1.5", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 2.0, 3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"kaj":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"kcg":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"kde":   {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"kea":   {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"kk":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Many: "n % 10 = 6,9 or n % 10 = 0 and n != 0 @integer 6, 9, 10, 16, 19, 20, 26, 29, 30, 36, 39, 40, 100, 1000, 10000, 100000, 1000000", Other: "@integer 0, 5, 7, 8, 11, 15, 17, 18, 21, 101, 1001"}},
+	"kkj":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"kl":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"km":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"kn":    {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ko":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ks":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ksb":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ksh":   {{Zero: "n = 0 @integer 0 @decimal 0.0, 0.00, 0.000, 0.0000", One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ku":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"kw":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ky":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"lag":   {{Zero: "n = 0 @integer 0 @decimal 0.0, 0.00, 0.000, 0.0000", One: "i = 0,1 and n != 0 @integer 1 @decimal 0.1, 1.6", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 2.0, 3.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"lb":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"lg":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"lkt":   {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ln":    {{One: "n = 0,1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"lo":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"lt":    {{One: "n % 10 = 1 and n % 100 != 11..19 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001 @decimal 1.0, 21.0, 31.0, 41.0, 51.0, 61.0, 71.0, 81.0, 101.0, 1001.0", Few: "n % 10 = 2..9 and n % 100 != 11..19 @integer 2, 9, 22, 29, 102, 1002 @decimal 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 22.0, 102.0, 1002.0", Many: "f != 0 @decimal 0.1, 0.9, 1.1, 1.7, 10.1, 100.1, 1000.1", Other: "@integer 0, 10, 20, 30, 40, 50, 60, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 10.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"lv":    {{Zero: "n % 10 = 0 or n % 100 = 11..19 or v = 2 and f % 100 = 11..19 @integer 0, 10, 20, 30, 40, 50, 60, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 10.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", One: "n % 10 = 1 and n % 100 != 11 or v = 2 and f % 10 = 1 and f % 100 != 11 or v != 2 and f % 10 = 1 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001 @decimal 0.1, 1.0, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1", Other: "@integer 2, 9, 22, 29, 102, 1002 @decimal 0.2, 0.9, 1.2, 1.9, 10.2, 100.2, 1000.2"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"mas":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"mg":    {{One: "n = 0,1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"mgo":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"mk":    {{One: "v = 0 and i % 10 = 1 or f % 10 = 1 @integer 1, 11, 21, 31, 41, 51, 61, 71, 101, 1001 @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1", Other: "@integer 0, 2, 10, 12, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.2, 1.0, 1.2, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "i % 10 = 1 and i % 100 != 11 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001", Two: "i % 10 = 2 and i % 100 != 12 @integer 2, 22, 32, 42, 52, 62, 72, 82, 102, 1002", Many: "i % 10 = 7,8 and i % 100 != 17,18 @integer 7, 8, 27, 28, 37, 38, 47, 48, 57, 58, 67, 68, 77, 78, 87, 88, 107, 1007", Other: "@integer 0, 3, 6, 9, 19, 100, 1000, 10000, 100000, 1000000"}},
+	"ml":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"mn":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"mo":    {{One: "i = 1 and v = 0 @integer 1", Few: "v != 0 or n = 0 or n != 1 and n % 100 = 1..19 @integer 0, 2, 16, 101, 1001 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", Other: "@integer 20, 35, 100, 1000, 10000, 100000, 1000000"}, {One: "n = 1 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"mr":    {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1 @integer 1", Two: "n = 2,3 @integer 2, 3", Few: "n = 4 @integer 4", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000"}},
+	"ms":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"mt":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Few: "n = 0 or n % 100 = 2..10 @integer 0, 2, 10, 102, 107, 1002 @decimal 0.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 10.0, 102.0, 1002.0", Many: "n % 100 = 11..19 @integer 11, 19, 111, 117, 1011 @decimal 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 17.0, 18.0, 111.0, 1011.0", Other: "@integer 20, 35, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"my":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"nah":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"naq":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"nb":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"nd":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ne":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1..4 @integer 1, 4", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000"}},
+	"nl":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"nn":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"nnh":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"no":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"nqo":   {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"nr":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"nso":   {{One: "n = 0,1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ny":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"nyn":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"om":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"or":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"os":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"pa":    {{One: "n = 0,1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"pap":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"pl":    {{One: "i = 1 and v = 0 @integer 1", Few: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 @integer 2, 4, 22, 24, 32, 34, 42, 44, 52, 54, 62, 102, 1002", Many: "v = 0 and i != 1 and i % 10 = 0,1 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 12..14 @integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000", Other: "@decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"prg":   {{Zero: "n % 10 = 0 or n % 100 = 11..19 or v = 2 and f % 100 = 11..19 @integer 0, 10, 20, 30, 40, 50, 60, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 10.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", One: "n % 10 = 1 and n % 100 != 11 or v = 2 and f % 10 = 1 and f % 100 != 11 or v != 2 and f % 10 = 1 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001 @decimal 0.1, 1.0, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1", Other: "@integer 2, 9, 22, 29, 102, 1002 @decimal 0.2, 0.9, 1.2, 1.9, 10.2, 100.2, 1000.2"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ps":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"pt":    {{One: "n = 0..2 and n != 2 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"pt-PT": {{One: "n = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"rm":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ro":    {{One: "i = 1 and v = 0 @integer 1", Few: "v != 0 or n = 0 or n != 1 and n % 100 = 1..19 @integer 0, 2, 16, 101, 1001 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", Other: "@integer 20, 35, 100, 1000, 10000, 100000, 1000000"}, {One: "n = 1 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"rof":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"root":  {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ru":    {{One: "v = 0 and i % 10 = 1 and i % 100 != 11 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001", Few: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 @integer 2, 4, 22, 24, 32, 34, 42, 44, 52, 54, 62, 102, 1002", Many: "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14 @integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000", Other: "@decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"rwk":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"sah":   {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"saq":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"se":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"seh":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ses":   {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"sg":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"sh":    {{One: "v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001 @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1", Few: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14 @integer 2, 4, 22, 24, 32, 34, 42, 44, 52, 54, 62, 102, 1002 @decimal 0.2, 0.4, 1.2, 1.4, 2.2, 2.4, 3.2, 3.4, 4.2, 4.4, 5.2, 10.2, 100.2, 1000.2", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.5, 1.0, 1.5, 2.0, 2.5, 2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"shi":   {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Few: "n = 2..10 @integer 2, 10 @decimal 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 2.00, 3.00, 4.00, 5.00, 6.00, 7.00, 8.00", Other: "@integer 11, 26, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 1.9, 2.1, 2.7, 10.1, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"si":    {{One: "n = 0,1 or i = 0 and f = 1 @integer 0, 1 @decimal 0.0, 0.1, 1.0, 0.00, 0.01, 1.00, 0.000, 0.001, 1.000, 0.0000, 0.0001, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.2, 0.9, 1.1, 1.8, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"sk":    {{One: "i = 1 and v = 0 @integer 1", Few: "i = 2..4 and v = 0 @integer 2, 4", Many: "v != 0 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"sl":    {{One: "v = 0 and i % 100 = 1 @integer 1, 101, 201, 301, 401, 501, 601, 701, 1001", Two: "v = 0 and i % 100 = 2 @integer 2, 102, 202, 302, 402, 502, 602, 702, 1002", Few: "v = 0 and i % 100 = 3,4 or v != 0 @integer 3, 4, 103, 104, 203, 204, 303, 304, 403, 404, 503, 504, 603, 604, 703, 704, 1003 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"sma":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"smi":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"smj":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"smn":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"sms":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Two: "n = 2 @integer 2 @decimal 2.0, 2.00, 2.000, 2.0000", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"sn":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"so":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"sq":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1 @integer 1", Many: "n % 10 = 4 and n % 100 != 14 @integer 4, 24, 34, 44, 54, 64, 74, 84, 104, 1004", Other: "@integer 0, 2, 3, 5, 17, 100, 1000, 10000, 100000, 1000000"}},
+	"sr":    {{One: "v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001 @decimal 0.1, 1.1, 2.1, 3.1, 4.1, 5.1, 6.1, 7.1, 10.1, 100.1, 1000.1", Few: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14 @integer 2, 4, 22, 24, 32, 34, 42, 44, 52, 54, 62, 102, 1002 @decimal 0.2, 0.4, 1.2, 1.4, 2.2, 2.4, 3.2, 3.4, 4.2, 4.4, 5.2, 10.2, 100.2, 1000.2", Other: "@integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.5, 1.0, 1.5, 2.0, 2.5, 2.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ss":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ssy":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"st":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"sv":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n % 10 = 1,2 and n % 100 != 11,12 @integer 1, 2, 21, 22, 31, 32, 41, 42, 51, 52, 61, 62, 71, 72, 81, 82, 101, 1001", Other: "@integer 0, 3, 17, 100, 1000, 10000, 100000, 1000000"}},
+	"sw":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"syr":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ta":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"te":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"teo":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"th":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ti":    {{One: "n = 0,1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"tig":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"tk":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"tl":    {{One: "v = 0 and i = 1..3 or v = 0 and i % 10 != 4,6,9 or v != 0 and f % 10 != 4,6,9 @integer 0, 3, 5, 7, 8, 10, 13, 15, 17, 18, 20, 21, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.3, 0.5, 0.7, 0.8, 1.0, 1.3, 1.5, 1.7, 1.8, 2.0, 2.1, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0", Other: "@integer 4, 6, 9, 14, 16, 19, 24, 26, 104, 1004 @decimal 0.4, 0.6, 0.9, 1.4, 1.6, 1.9, 2.4, 2.6, 10.4, 100.4, 1000.4"}, {One: "n = 1 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"tn":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"to":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"tr":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ts":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"tzm":   {{One: "n = 0,1,11..99 @integer 0, 1, 11, 24 @decimal 0.0, 1.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0, 17.0, 18.0, 19.0, 20.0, 21.0, 22.0, 23.0, 24.0", Other: "@integer 2, 10, 100, 106, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"ug":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"uk":    {{One: "v = 0 and i % 10 = 1 and i % 100 != 11 @integer 1, 21, 31, 41, 51, 61, 71, 81, 101, 1001", Few: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 @integer 2, 4, 22, 24, 32, 34, 42, 44, 52, 54, 62, 102, 1002", Many: "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14 @integer 0, 5, 19, 100, 1000, 10000, 100000, 1000000", Other: "@decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Few: "n % 10 = 3 and n % 100 != 13 @integer 3, 23, 33, 43, 53, 63, 73, 83, 103, 1003", Other: "@integer 0, 2, 4, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"ur":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"uz":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"ve":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"vi":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {One: "n = 1 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000"}},
+	"vo":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"vun":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"wa":    {{One: "n = 0,1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 1.00, 0.000, 1.000, 0.0000, 1.0000", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 0.1, 0.9, 1.1, 1.7, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"wae":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"wo":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"xh":    {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"xog":   {{One: "n = 1 @integer 1 @decimal 1.0, 1.00, 1.000, 1.0000", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 0.9, 1.1, 1.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"yi":    {{One: "i = 1 and v = 0 @integer 1", Other: "@integer 0, 2, 16, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"yo":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, nil},
+	"zh":    {{Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000 @decimal 0.0, 1.5, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+	"zu":    {{One: "i = 0 or n = 1 @integer 0, 1 @decimal 0.0, 1.0, 0.00, 0.04", Other: "@integer 2, 17, 100, 1000, 10000, 100000, 1000000 @decimal 1.1, 2.6, 10.0, 100.0, 1000.0, 10000.0, 100000.0, 1000000.0"}, {Other: "@integer 0, 15, 100, 1000, 10000, 100000, 1000000"}},
+}
+
+// Values make-plural checks the rules on (see its -analyze option), see TestCompile
+var plural_domain = []string{
+	"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15",
+	"16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31",
+	"32", "33", "34", "35", "36", "37", "38", "39", "40", "41", "42", "43", "44", "45", "46", "47",
+	"48", "49", "50", "51", "52", "53", "54", "55", "56", "57", "58", "59", "60", "61", "62", "63",
+	"64", "65", "66", "67", "68", "69", "70", "71", "72", "73", "74", "75", "76", "77", "78", "79",
+	"80", "81", "82", "83", "84", "85", "86", "87", "88", "89", "90", "91", "92", "93", "94", "95",
+	"96", "97", "98", "99", "100", "101", "102", "103", "104", "105", "106", "107", "108", "109", "110", "111",
+	"112", "113", "114", "115", "116", "117", "118", "119", "120", "121", "122", "123", "124", "125", "126", "127",
+	"128", "129", "130", "131", "132", "133", "134", "135", "136", "137", "138", "139", "140", "141", "142", "143",
+	"144", "145", "146", "147", "148", "149", "150", "151", "152", "153", "154", "155", "156", "157", "158", "159",
+	"160", "161", "162", "163", "164", "165", "166", "167", "168", "169", "170", "171", "172", "173", "174", "175",
+	"176", "177", "178", "179", "180", "181", "182", "183", "184", "185", "186", "187", "188", "189", "190", "191",
+	"192", "193", "194", "195", "196", "197", "198", "199", "200", "201", "202", "203", "204", "205", "206", "207",
+	"208", "209", "210", "211", "212", "213", "214", "215", "216", "217", "218", "219", "220", "221", "222", "223",
+	"224", "225", "226", "227", "228", "229", "230", "231", "232", "233", "234", "235", "236", "237", "238", "239",
+	"240", "241", "242", "243", "244", "245", "246", "247", "248", "249", "250", "251", "252", "253", "254", "255",
+	"256", "257", "258", "259", "260", "261", "262", "263", "264", "265", "266", "267", "268", "269", "270", "271",
+	"272", "273", "274", "275", "276", "277", "278", "279", "280", "281", "282", "283", "284", "285", "286", "287",
+	"288", "289", "290", "291", "292", "293", "294", "295", "296", "297", "298", "299", "300", "301", "302", "303",
+	"304", "305", "306", "307", "308", "309", "310", "311", "312", "313", "314", "315", "316", "317", "318", "319",
+	"320", "321", "322", "323", "324", "325", "326", "327", "328", "329", "330", "331", "332", "333", "334", "335",
+	"336", "337", "338", "339", "340", "341", "342", "343", "344", "345", "346", "347", "348", "349", "350", "351",
+	"352", "353", "354", "355", "356", "357", "358", "359", "360", "361", "362", "363", "364", "365", "366", "367",
+	"368", "369", "370", "371", "372", "373", "374", "375", "376", "377", "378", "379", "380", "381", "382", "383",
+	"384", "385", "386", "387", "388", "389", "390", "391", "392", "393", "394", "395", "396", "397", "398", "399",
+	"400", "401", "402", "403", "404", "405", "406", "407", "408", "409", "410", "411", "412", "413", "414", "415",
+	"416", "417", "418", "419", "420", "421", "422", "423", "424", "425", "426", "427", "428", "429", "430", "431",
+	"432", "433", "434", "435", "436", "437", "438", "439", "440", "441", "442", "443", "444", "445", "446", "447",
+	"448", "449", "450", "451", "452", "453", "454", "455", "456", "457", "458", "459", "460", "461", "462", "463",
+	"464", "465", "466", "467", "468", "469", "470", "471", "472", "473", "474", "475", "476", "477", "478", "479",
+	"480", "481", "482", "483", "484", "485", "486", "487", "488", "489", "490", "491", "492", "493", "494", "495",
+	"496", "497", "498", "499", "500", "501", "502", "503", "504", "505", "506", "507", "508", "509", "510", "511",
+	"512", "513", "514", "515", "516", "517", "518", "519", "520", "521", "522", "523", "524", "525", "526", "527",
+	"528", "529", "530", "531", "532", "533", "534", "535", "536", "537", "538", "539", "540", "541", "542", "543",
+	"544", "545", "546", "547", "548", "549", "550", "551", "552", "553", "554", "555", "556", "557", "558", "559",
+	"560", "561", "562", "563", "564", "565", "566", "567", "568", "569", "570", "571", "572", "573", "574", "575",
+	"576", "577", "578", "579", "580", "581", "582", "583", "584", "585", "586", "587", "588", "589", "590", "591",
+	"592", "593", "594", "595", "596", "597", "598", "599", "600", "601", "602", "603", "604", "605", "606", "607",
+	"608", "609", "610", "611", "612", "613", "614", "615", "616", "617", "618", "619", "620", "621", "622", "623",
+	"624", "625", "626", "627", "628", "629", "630", "631", "632", "633", "634", "635", "636", "637", "638", "639",
+	"640", "641", "642", "643", "644", "645", "646", "647", "648", "649", "650", "651", "652", "653", "654", "655",
+	"656", "657", "658", "659", "660", "661", "662", "663", "664", "665", "666", "667", "668", "669", "670", "671",
+	"672", "673", "674", "675", "676", "677", "678", "679", "680", "681", "682", "683", "684", "685", "686", "687",
+	"688", "689", "690", "691", "692", "693", "694", "695", "696", "697", "698", "699", "700", "701", "702", "703",
+	"704", "705", "706", "707", "708", "709", "710", "711", "712", "713", "714", "715", "716", "717", "718", "719",
+	"720", "721", "722", "723", "724", "725", "726", "727", "728", "729", "730", "731", "732", "733", "734", "735",
+	"736", "737", "738", "739", "740", "741", "742", "743", "744", "745", "746", "747", "748", "749", "750", "751",
+	"752", "753", "754", "755", "756", "757", "758", "759", "760", "761", "762", "763", "764", "765", "766", "767",
+	"768", "769", "770", "771", "772", "773", "774", "775", "776", "777", "778", "779", "780", "781", "782", "783",
+	"784", "785", "786", "787", "788", "789", "790", "791", "792", "793", "794", "795", "796", "797", "798", "799",
+	"800", "801", "802", "803", "804", "805", "806", "807", "808", "809", "810", "811", "812", "813", "814", "815",
+	"816", "817", "818", "819", "820", "821", "822", "823", "824", "825", "826", "827", "828", "829", "830", "831",
+	"832", "833", "834", "835", "836", "837", "838", "839", "840", "841", "842", "843", "844", "845", "846", "847",
+	"848", "849", "850", "851", "852", "853", "854", "855", "856", "857", "858", "859", "860", "861", "862", "863",
+	"864", "865", "866", "867", "868", "869", "870", "871", "872", "873", "874", "875", "876", "877", "878", "879",
+	"880", "881", "882", "883", "884", "885", "886", "887", "888", "889", "890", "891", "892", "893", "894", "895",
+	"896", "897", "898", "899", "900", "901", "902", "903", "904", "905", "906", "907", "908", "909", "910", "911",
+	"912", "913", "914", "915", "916", "917", "918", "919", "920", "921", "922", "923", "924", "925", "926", "927",
+	"928", "929", "930", "931", "932", "933", "934", "935", "936", "937", "938", "939", "940", "941", "942", "943",
+	"944", "945", "946", "947", "948", "949", "950", "951", "952", "953", "954", "955", "956", "957", "958", "959",
+	"960", "961", "962", "963", "964", "965", "966", "967", "968", "969", "970", "971", "972", "973", "974", "975",
+	"976", "977", "978", "979", "980", "981", "982", "983", "984", "985", "986", "987", "988", "989", "990", "991",
+	"992", "993", "994", "995", "996", "997", "998", "999", "1000", "1001", "1002", "1003", "1004", "1005", "1006", "1007",
+	"1008", "1009", "1010", "1011", "1012", "1013", "1014", "1015", "1016", "1017", "1018", "1019", "1020", "1021", "1022", "1023",
+	"1024", "1025", "1026", "1027", "1028", "1029", "1030", "1031", "1032", "1033", "1034", "1035", "1036", "1037", "1038", "1039",
+	"1040", "1041", "1042", "1043", "1044", "1045", "1046", "1047", "1048", "1049", "1050", "1051", "1052", "1053", "1054", "1055",
+	"1056", "1057", "1058", "1059", "1060", "1061", "1062", "1063", "1064", "1065", "1066", "1067", "1068", "1069", "1070", "1071",
+	"1072", "1073", "1074", "1075", "1076", "1077", "1078", "1079", "1080", "1081", "1082", "1083", "1084", "1085", "1086", "1087",
+	"1088", "1089", "1090", "1091", "1092", "1093", "1094", "1095", "1096", "1097", "1098", "1099", "1100", "1101", "1102", "1103",
+	"1104", "1105", "1106", "1107", "1108", "1109", "1110", "1111", "1112", "1113", "1114", "1115", "1116", "1117", "1118", "1119",
+	"1120", "1121", "1122", "1123", "1124", "1125", "1126", "1127", "1128", "1129", "1130", "1131", "1132", "1133", "1134", "1135",
+	"1136", "1137", "1138", "1139", "1140", "1141", "1142", "1143", "1144", "1145", "1146", "1147", "1148", "1149", "1150", "1151",
+	"1152", "1153", "1154", "1155", "1156", "1157", "1158", "1159", "1160", "1161", "1162", "1163", "1164", "1165", "1166", "1167",
+	"1168", "1169", "1170", "1171", "1172", "1173", "1174", "1175", "1176", "1177", "1178", "1179", "1180", "1181", "1182", "1183",
+	"1184", "1185", "1186", "1187", "1188", "1189", "1190", "1191", "1192", "1193", "1194", "1195", "1196", "1197", "1198", "1199",
+	"1200", "10000", "20000", "10001", "20001", "10002", "20002", "10003", "20003", "10004", "20004", "10005", "20005", "10006", "20006", "10007",
+	"20007", "10008", "20008", "10009", "20009", "10010", "20010", "10011", "20011", "10012", "20012", "10013", "20013", "10014", "20014", "10015",
+	"20015", "10016", "20016", "10017", "20017", "10018", "20018", "10019", "20019", "10020", "20020", "10021", "20021", "10022", "20022", "10023",
+	"20023", "10024", "20024", "10025", "20025", "10026", "20026", "10027", "20027", "10028", "20028", "10029", "20029", "10030", "20030", "10031",
+	"20031", "10032", "20032", "10033", "20033", "10034", "20034", "10035", "20035", "10036", "20036", "10037", "20037", "10038", "20038", "10039",
+	"20039", "10040", "20040", "10041", "20041", "10042", "20042", "10043", "20043", "10044", "20044", "10045", "20045", "10046", "20046", "10047",
+	"20047", "10048", "20048", "10049", "20049", "10050", "20050", "10051", "20051", "10052", "20052", "10053", "20053", "10054", "20054", "10055",
+	"20055", "10056", "20056", "10057", "20057", "10058", "20058", "10059", "20059", "10060", "20060", "10061", "20061", "10062", "20062", "10063",
+	"20063", "10064", "20064", "10065", "20065", "10066", "20066", "10067", "20067", "10068", "20068", "10069", "20069", "10070", "20070", "10071",
+	"20071", "10072", "20072", "10073", "20073", "10074", "20074", "10075", "20075", "10076", "20076", "10077", "20077", "10078", "20078", "10079",
+	"20079", "10080", "20080", "10081", "20081", "10082", "20082", "10083", "20083", "10084", "20084", "10085", "20085", "10086", "20086", "10087",
+	"20087", "10088", "20088", "10089", "20089", "10090", "20090", "10091", "20091", "10092", "20092", "10093", "20093", "10094", "20094", "10095",
+	"20095", "10096", "20096", "10097", "20097", "10098", "20098", "10099", "20099", "10100", "20100", "10101", "20101", "10102", "20102", "10103",
+	"20103", "10104", "20104", "10105", "20105", "10106", "20106", "10107", "20107", "10108", "20108", "10109", "20109", "10110", "20110", "10111",
+	"20111", "10112", "20112", "10113", "20113", "10114", "20114", "10115", "20115", "10116", "20116", "10117", "20117", "10118", "20118", "10119",
+	"20119", "10120", "20120", "100000", "200000", "100001", "200001", "100002", "200002", "100003", "200003", "100004", "200004", "100005", "200005", "100006",
+	"200006", "100007", "200007", "100008", "200008", "100009", "200009", "100010", "200010", "100011", "200011", "100012", "200012", "100013", "200013", "100014",
+	"200014", "100015", "200015", "100016", "200016", "100017", "200017", "100018", "200018", "100019", "200019", "100020", "200020", "100021", "200021", "100022",
+	"200022", "100023", "200023", "100024", "200024", "100025", "200025", "100026", "200026", "100027", "200027", "100028", "200028", "100029", "200029", "100030",
+	"200030", "100031", "200031", "100032", "200032", "100033", "200033", "100034", "200034", "100035", "200035", "100036", "200036", "100037", "200037", "100038",
+	"200038", "100039", "200039", "100040", "200040", "100041", "200041", "100042", "200042", "100043", "200043", "100044", "200044", "100045", "200045", "100046",
+	"200046", "100047", "200047", "100048", "200048", "100049", "200049", "100050", "200050", "100051", "200051", "100052", "200052", "100053", "200053", "100054",
+	"200054", "100055", "200055", "100056", "200056", "100057", "200057", "100058", "200058", "100059", "200059", "100060", "200060", "100061", "200061", "100062",
+	"200062", "100063", "200063", "100064", "200064", "100065", "200065", "100066", "200066", "100067", "200067", "100068", "200068", "100069", "200069", "100070",
+	"200070", "100071", "200071", "100072", "200072", "100073", "200073", "100074", "200074", "100075", "200075", "100076", "200076", "100077", "200077", "100078",
+	"200078", "100079", "200079", "100080", "200080", "100081", "200081", "100082", "200082", "100083", "200083", "100084", "200084", "100085", "200085", "100086",
+	"200086", "100087", "200087", "100088", "200088", "100089", "200089", "100090", "200090", "100091", "200091", "100092", "200092", "100093", "200093", "100094",
+	"200094", "100095", "200095", "100096", "200096", "100097", "200097", "100098", "200098", "100099", "200099", "100100", "200100", "100101", "200101", "100102",
+	"200102", "100103", "200103", "100104", "200104", "100105", "200105", "100106", "200106", "100107", "200107", "100108", "200108", "100109", "200109", "100110",
+	"200110", "100111", "200111", "100112", "200112", "100113", "200113", "100114", "200114", "100115", "200115", "100116", "200116", "100117", "200117", "100118",
+	"200118", "100119", "200119", "100120", "200120", "1000000", "2000000", "1000001", "2000001", "1000002", "2000002", "1000003", "2000003", "1000004", "2000004", "1000005",
+	"2000005", "1000006", "2000006", "1000007", "2000007", "1000008", "2000008", "1000009", "2000009", "1000010", "2000010", "1000011", "2000011", "1000012", "2000012", "1000013",
+	"2000013", "1000014", "2000014", "1000015", "2000015", "1000016", "2000016", "1000017", "2000017", "1000018", "2000018", "1000019", "2000019", "1000020", "2000020", "1000021",
+	"2000021", "1000022", "2000022", "1000023", "2000023", "1000024", "2000024", "1000025", "2000025", "1000026", "2000026", "1000027", "2000027", "1000028", "2000028", "1000029",
+	"2000029", "1000030", "2000030", "1000031", "2000031", "1000032", "2000032", "1000033", "2000033", "1000034", "2000034", "1000035", "2000035", "1000036", "2000036", "1000037",
+	"2000037", "1000038", "2000038", "1000039", "2000039", "1000040", "2000040", "1000041", "2000041", "1000042", "2000042", "1000043", "2000043", "1000044", "2000044", "1000045",
+	"2000045", "1000046", "2000046", "1000047", "2000047", "1000048", "2000048", "1000049", "2000049", "1000050", "2000050", "1000051", "2000051", "1000052", "2000052", "1000053",
+	"2000053", "1000054", "2000054", "1000055", "2000055", "1000056", "2000056", "1000057", "2000057", "1000058", "2000058", "1000059", "2000059", "1000060", "2000060", "1000061",
+	"2000061", "1000062", "2000062", "1000063", "2000063", "1000064", "2000064", "1000065", "2000065", "1000066", "2000066", "1000067", "2000067", "1000068", "2000068", "1000069",
+	"2000069", "1000070", "2000070", "1000071", "2000071", "1000072", "2000072", "1000073", "2000073", "1000074", "2000074", "1000075", "2000075", "1000076", "2000076", "1000077",
+	"2000077", "1000078", "2000078", "1000079", "2000079", "1000080", "2000080", "1000081", "2000081", "1000082", "2000082", "1000083", "2000083", "1000084", "2000084", "1000085",
+	"2000085", "1000086", "2000086", "1000087", "2000087", "1000088", "2000088", "1000089", "2000089", "1000090", "2000090", "1000091", "2000091", "1000092", "2000092", "1000093",
+	"2000093", "1000094", "2000094", "1000095", "2000095", "1000096", "2000096", "1000097", "2000097", "1000098", "2000098", "1000099", "2000099", "1000100", "2000100", "1000101",
+	"2000101", "1000102", "2000102", "1000103", "2000103", "1000104", "2000104", "1000105", "2000105", "1000106", "2000106", "1000107", "2000107", "1000108", "2000108", "1000109",
+	"2000109", "1000110", "2000110", "1000111", "2000111", "1000112", "2000112", "1000113", "2000113", "1000114", "2000114", "1000115", "2000115", "1000116", "2000116", "1000117",
+	"2000117", "1000118", "2000118", "1000119", "2000119", "1000120", "2000120", "10000000", "20000000", "10000001", "20000001", "10000002", "20000002", "10000003", "20000003", "10000004",
+	"20000004", "10000005", "20000005", "10000006", "20000006", "10000007", "20000007", "10000008", "20000008", "10000009", "20000009", "10000010", "20000010", "10000011", "20000011", "10000012",
+	"20000012", "10000013", "20000013", "10000014", "20000014", "10000015", "20000015", "10000016", "20000016", "10000017", "20000017", "10000018", "20000018", "10000019", "20000019", "10000020",
+	"20000020", "10000021", "20000021", "10000022", "20000022", "10000023", "20000023", "10000024", "20000024", "10000025", "20000025", "10000026", "20000026", "10000027", "20000027", "10000028",
+	"20000028", "10000029", "20000029", "10000030", "20000030", "10000031", "20000031", "10000032", "20000032", "10000033", "20000033", "10000034", "20000034", "10000035", "20000035", "10000036",
+	"20000036", "10000037", "20000037", "10000038", "20000038", "10000039", "20000039", "10000040", "20000040", "10000041", "20000041", "10000042", "20000042", "10000043", "20000043", "10000044",
+	"20000044", "10000045", "20000045", "10000046", "20000046", "10000047", "20000047", "10000048", "20000048", "10000049", "20000049", "10000050", "20000050", "10000051", "20000051", "10000052",
+	"20000052", "10000053", "20000053", "10000054", "20000054", "10000055", "20000055", "10000056", "20000056", "10000057", "20000057", "10000058", "20000058", "10000059", "20000059", "10000060",
+	"20000060", "10000061", "20000061", "10000062", "20000062", "10000063", "20000063", "10000064", "20000064", "10000065", "20000065", "10000066", "20000066", "10000067", "20000067", "10000068",
+	"20000068", "10000069", "20000069", "10000070", "20000070", "10000071", "20000071", "10000072", "20000072", "10000073", "20000073", "10000074", "20000074", "10000075", "20000075", "10000076",
+	"20000076", "10000077", "20000077", "10000078", "20000078", "10000079", "20000079", "10000080", "20000080", "10000081", "20000081", "10000082", "20000082", "10000083", "20000083", "10000084",
+	"20000084", "10000085", "20000085", "10000086", "20000086", "10000087", "20000087", "10000088", "20000088", "10000089", "20000089", "10000090", "20000090", "10000091", "20000091", "10000092",
+	"20000092", "10000093", "20000093", "10000094", "20000094", "10000095", "20000095", "10000096", "20000096", "10000097", "20000097", "10000098", "20000098", "10000099", "20000099", "10000100",
+	"20000100", "10000101", "20000101", "10000102", "20000102", "10000103", "20000103", "10000104", "20000104", "10000105", "20000105", "10000106", "20000106", "10000107", "20000107", "10000108",
+	"20000108", "10000109", "20000109", "10000110", "20000110", "10000111", "20000111", "10000112", "20000112", "10000113", "20000113", "10000114", "20000114", "10000115", "20000115", "10000116",
+	"20000116", "10000117", "20000117", "10000118", "20000118", "10000119", "20000119", "10000120", "20000120", "0.00", "0.0", "0.01", "0.02", "0.03", "0.04", "0.05",
+	"0.06", "0.07", "0.08", "0.09", "0.10", "0.1", "0.11", "0.12", "0.13", "0.14", "0.15", "0.16", "0.17", "0.18", "0.19", "0.20",
+	"0.2", "0.21", "0.22", "0.23", "0.24", "0.25", "0.26", "0.27", "0.28", "0.29", "0.30", "0.3", "0.31", "0.32", "0.33", "0.34",
+	"0.35", "0.36", "0.37", "0.38", "0.39", "0.40", "0.4", "0.41", "0.42", "0.43", "0.44", "0.45", "0.46", "0.47", "0.48", "0.49",
+	"0.50", "0.5", "0.51", "0.52", "0.53", "0.54", "0.55", "0.56", "0.57", "0.58", "0.59", "0.60", "0.6", "0.61", "0.62", "0.63",
+	"0.64", "0.65", "0.66", "0.67", "0.68", "0.69", "0.70", "0.7", "0.71", "0.72", "0.73", "0.74", "0.75", "0.76", "0.77", "0.78",
+	"0.79", "0.80", "0.8", "0.81", "0.82", "0.83", "0.84", "0.85", "0.86", "0.87", "0.88", "0.89", "0.90", "0.9", "0.91", "0.92",
+	"0.93", "0.94", "0.95", "0.96", "0.97", "0.98", "0.99", "1.00", "1.0", "1.01", "1.02", "1.03", "1.04", "1.05", "1.06", "1.07",
+	"1.08", "1.09", "1.10", "1.1", "1.11", "1.12", "1.13", "1.14", "1.15", "1.16", "1.17", "1.18", "1.19", "1.20", "1.2", "1.21",
+	"1.22", "1.23", "1.24", "1.25", "1.26", "1.27", "1.28", "1.29", "1.30", "1.3", "1.31", "1.32", "1.33", "1.34", "1.35", "1.36",
+	"1.37", "1.38", "1.39", "1.40", "1.4", "1.41", "1.42", "1.43", "1.44", "1.45", "1.46", "1.47", "1.48", "1.49", "1.50", "1.5",
+	"1.51", "1.52", "1.53", "1.54", "1.55", "1.56", "1.57", "1.58", "1.59", "1.60", "1.6", "1.61", "1.62", "1.63", "1.64", "1.65",
+	"1.66", "1.67", "1.68", "1.69", "1.70", "1.7", "1.71", "1.72", "1.73", "1.74", "1.75", "1.76", "1.77", "1.78", "1.79", "1.80",
+	"1.8", "1.81", "1.82", "1.83", "1.84", "1.85", "1.86", "1.87", "1.88", "1.89", "1.90", "1.9", "1.91", "1.92", "1.93", "1.94",
+	"1.95", "1.96", "1.97", "1.98", "1.99", "2.00", "2.0", "2.01", "2.02", "2.03", "2.04", "2.05", "2.06", "2.07", "2.08", "2.09",
+	"2.10", "2.1", "2.11", "2.12", "2.13", "2.14", "2.15", "2.16", "2.17", "2.18", "2.19", "2.20", "2.2", "2.21", "2.22", "2.23",
+	"2.24", "2.25", "2.26", "2.27", "2.28", "2.29", "2.30", "2.3", "2.31", "2.32", "2.33", "2.34", "2.35", "2.36", "2.37", "2.38",
+	"2.39", "2.40", "2.4", "2.41", "2.42", "2.43", "2.44", "2.45", "2.46", "2.47", "2.48", "2.49", "2.50", "2.5", "2.51", "2.52",
+	"2.53", "2.54", "2.55", "2.56", "2.57", "2.58", "2.59", "2.60", "2.6", "2.61", "2.62", "2.63", "2.64", "2.65", "2.66", "2.67",
+	"2.68", "2.69", "2.70", "2.7", "2.71", "2.72", "2.73", "2.74", "2.75", "2.76", "2.77", "2.78", "2.79", "2.80", "2.8", "2.81",
+	"2.82", "2.83", "2.84", "2.85", "2.86", "2.87", "2.88", "2.89", "2.90", "2.9", "2.91", "2.92", "2.93", "2.94", "2.95", "2.96",
+	"2.97", "2.98", "2.99", "3.00", "3.0", "3.01", "3.02", "3.03", "3.04", "3.05", "3.06", "3.07", "3.08", "3.09", "3.10", "3.1",
+	"3.11", "3.12", "3.13", "3.14", "3.15", "3.16", "3.17", "3.18", "3.19", "3.20", "3.2", "3.21", "3.22", "3.23", "3.24", "3.25",
+	"3.26", "3.27", "3.28", "3.29", "3.30", "3.3", "3.31", "3.32", "3.33", "3.34", "3.35", "3.36", "3.37", "3.38", "3.39", "3.40",
+	"3.4", "3.41", "3.42", "3.43", "3.44", "3.45", "3.46", "3.47", "3.48", "3.49", "3.50", "3.5", "3.51", "3.52", "3.53", "3.54",
+	"3.55", "3.56", "3.57", "3.58", "3.59", "3.60", "3.6", "3.61", "3.62", "3.63", "3.64", "3.65", "3.66", "3.67", "3.68", "3.69",
+	"3.70", "3.7", "3.71", "3.72", "3.73", "3.74", "3.75", "3.76", "3.77", "3.78", "3.79", "3.80", "3.8", "3.81", "3.82", "3.83",
+	"3.84", "3.85", "3.86", "3.87", "3.88", "3.89", "3.90", "3.9", "3.91", "3.92", "3.93", "3.94", "3.95", "3.96", "3.97", "3.98",
+	"3.99", "4.00", "4.0", "4.01", "4.02", "4.03", "4.04", "4.05", "4.06", "4.07", "4.08", "4.09", "4.10", "4.1", "4.11", "4.12",
+	"4.13", "4.14", "4.15", "4.16", "4.17", "4.18", "4.19", "4.20", "4.2", "4.21", "4.22", "4.23", "4.24", "4.25", "4.26", "4.27",
+	"4.28", "4.29", "4.30", "4.3", "4.31", "4.32", "4.33", "4.34", "4.35", "4.36", "4.37", "4.38", "4.39", "4.40", "4.4", "4.41",
+	"4.42", "4.43", "4.44", "4.45", "4.46", "4.47", "4.48", "4.49", "4.50", "4.5", "4.51", "4.52", "4.53", "4.54", "4.55", "4.56",
+	"4.57", "4.58", "4.59", "4.60", "4.6", "4.61", "4.62", "4.63", "4.64", "4.65", "4.66", "4.67", "4.68", "4.69", "4.70", "4.7",
+	"4.71", "4.72", "4.73", "4.74", "4.75", "4.76", "4.77", "4.78", "4.79", "4.80", "4.8", "4.81", "4.82", "4.83", "4.84", "4.85",
+	"4.86", "4.87", "4.88", "4.89", "4.90", "4.9", "4.91", "4.92", "4.93", "4.94", "4.95", "4.96", "4.97", "4.98", "4.99", "5.00",
+	"5.0", "5.01", "5.02", "5.03", "5.04", "5.05", "5.06", "5.07", "5.08", "5.09", "5.10", "5.1", "5.11", "5.12", "5.13", "5.14",
+	"5.15", "5.16", "5.17", "5.18", "5.19", "5.20", "5.2", "5.21", "5.22", "5.23", "5.24", "5.25", "5.26", "5.27", "5.28", "5.29",
+	"5.30", "5.3", "5.31", "5.32", "5.33", "5.34", "5.35", "5.36", "5.37", "5.38", "5.39", "5.40", "5.4", "5.41", "5.42", "5.43",
+	"5.44", "5.45", "5.46", "5.47", "5.48", "5.49", "5.50", "5.5", "5.51", "5.52", "5.53", "5.54", "5.55", "5.56", "5.57", "5.58",
+	"5.59", "5.60", "5.6", "5.61", "5.62", "5.63", "5.64", "5.65", "5.66", "5.67", "5.68", "5.69", "5.70", "5.7", "5.71", "5.72",
+	"5.73", "5.74", "5.75", "5.76", "5.77", "5.78", "5.79", "5.80", "5.8", "5.81", "5.82", "5.83", "5.84", "5.85", "5.86", "5.87",
+	"5.88", "5.89", "5.90", "5.9", "5.91", "5.92", "5.93", "5.94", "5.95", "5.96", "5.97", "5.98", "5.99", "6.00", "6.0", "6.01",
+	"6.02", "6.03", "6.04", "6.05", "6.06", "6.07", "6.08", "6.09", "6.10", "6.1", "6.11", "6.12", "6.13", "6.14", "6.15", "6.16",
+	"6.17", "6.18", "6.19", "6.20", "6.2", "6.21", "6.22", "6.23", "6.24", "6.25", "6.26", "6.27", "6.28", "6.29", "6.30", "6.3",
+	"6.31", "6.32", "6.33", "6.34", "6.35", "6.36", "6.37", "6.38", "6.39", "6.40", "6.4", "6.41", "6.42", "6.43", "6.44", "6.45",
+	"6.46", "6.47", "6.48", "6.49", "6.50", "6.5", "6.51", "6.52", "6.53", "6.54", "6.55", "6.56", "6.57", "6.58", "6.59", "6.60",
+	"6.6", "6.61", "6.62", "6.63", "6.64", "6.65", "6.66", "6.67", "6.68", "6.69", "6.70", "6.7", "6.71", "6.72", "6.73", "6.74",
+	"6.75", "6.76", "6.77", "6.78", "6.79", "6.80", "6.8", "6.81", "6.82", "6.83", "6.84", "6.85", "6.86", "6.87", "6.88", "6.89",
+	"6.90", "6.9", "6.91", "6.92", "6.93", "6.94", "6.95", "6.96", "6.97", "6.98", "6.99", "7.00", "7.0", "7.01", "7.02", "7.03",
+	"7.04", "7.05", "7.06", "7.07", "7.08", "7.09", "7.10", "7.1", "7.11", "7.12", "7.13", "7.14", "7.15", "7.16", "7.17", "7.18",
+	"7.19", "7.20", "7.2", "7.21", "7.22", "7.23", "7.24", "7.25", "7.26", "7.27", "7.28", "7.29", "7.30", "7.3", "7.31", "7.32",
+	"7.33", "7.34", "7.35", "7.36", "7.37", "7.38", "7.39", "7.40", "7.4", "7.41", "7.42", "7.43", "7.44", "7.45", "7.46", "7.47",
+	"7.48", "7.49", "7.50", "7.5", "7.51", "7.52", "7.53", "7.54", "7.55", "7.56", "7.57", "7.58", "7.59", "7.60", "7.6", "7.61",
+	"7.62", "7.63", "7.64", "7.65", "7.66", "7.67", "7.68", "7.69", "7.70", "7.7", "7.71", "7.72", "7.73", "7.74", "7.75", "7.76",
+	"7.77", "7.78", "7.79", "7.80", "7.8", "7.81", "7.82", "7.83", "7.84", "7.85", "7.86", "7.87", "7.88", "7.89", "7.90", "7.9",
+	"7.91", "7.92", "7.93", "7.94", "7.95", "7.96", "7.97", "7.98", "7.99", "8.00", "8.0", "8.01", "8.02", "8.03", "8.04", "8.05",
+	"8.06", "8.07", "8.08", "8.09", "8.10", "8.1", "8.11", "8.12", "8.13", "8.14", "8.15", "8.16", "8.17", "8.18", "8.19", "8.20",
+	"8.2", "8.21", "8.22", "8.23", "8.24", "8.25", "8.26", "8.27", "8.28", "8.29", "8.30", "8.3", "8.31", "8.32", "8.33", "8.34",
+	"8.35", "8.36", "8.37", "8.38", "8.39", "8.40", "8.4", "8.41", "8.42", "8.43", "8.44", "8.45", "8.46", "8.47", "8.48", "8.49",
+	"8.50", "8.5", "8.51", "8.52", "8.53", "8.54", "8.55", "8.56", "8.57", "8.58", "8.59", "8.60", "8.6", "8.61", "8.62", "8.63",
+	"8.64", "8.65", "8.66", "8.67", "8.68", "8.69", "8.70", "8.7", "8.71", "8.72", "8.73", "8.74", "8.75", "8.76", "8.77", "8.78",
+	"8.79", "8.80", "8.8", "8.81", "8.82", "8.83", "8.84", "8.85", "8.86", "8.87", "8.88", "8.89", "8.90", "8.9", "8.91", "8.92",
+	"8.93", "8.94", "8.95", "8.96", "8.97", "8.98", "8.99", "9.00", "9.0", "9.01", "9.02", "9.03", "9.04", "9.05", "9.06", "9.07",
+	"9.08", "9.09", "9.10", "9.1", "9.11", "9.12", "9.13", "9.14", "9.15", "9.16", "9.17", "9.18", "9.19", "9.20", "9.2", "9.21",
+	"9.22", "9.23", "9.24", "9.25", "9.26", "9.27", "9.28", "9.29", "9.30", "9.3", "9.31", "9.32", "9.33", "9.34", "9.35", "9.36",
+	"9.37", "9.38", "9.39", "9.40", "9.4", "9.41", "9.42", "9.43", "9.44", "9.45", "9.46", "9.47", "9.48", "9.49", "9.50", "9.5",
+	"9.51", "9.52", "9.53", "9.54", "9.55", "9.56", "9.57", "9.58", "9.59", "9.60", "9.6", "9.61", "9.62", "9.63", "9.64", "9.65",
+	"9.66", "9.67", "9.68", "9.69", "9.70", "9.7", "9.71", "9.72", "9.73", "9.74", "9.75", "9.76", "9.77", "9.78", "9.79", "9.80",
+	"9.8", "9.81", "9.82", "9.83", "9.84", "9.85", "9.86", "9.87", "9.88", "9.89", "9.90", "9.9", "9.91", "9.92", "9.93", "9.94",
+	"9.95", "9.96", "9.97", "9.98", "9.99", "10.00", "10.0", "10.01", "10.02", "10.03", "10.04", "10.05", "10.06", "10.07", "10.08", "10.09",
+	"10.10", "10.1", "10.11", "10.12", "10.13", "10.14", "10.15", "10.16", "10.17", "10.18", "10.19", "10.20", "10.2", "10.21", "10.22", "10.23",
+	"10.24", "10.25", "10.26", "10.27", "10.28", "10.29", "10.30", "10.3", "10.31", "10.32", "10.33", "10.34", "10.35", "10.36", "10.37", "10.38",
+	"10.39", "10.40", "10.4", "10.41", "10.42", "10.43", "10.44", "10.45", "10.46", "10.47", "10.48", "10.49", "10.50", "10.5", "10.51", "10.52",
+	"10.53", "10.54", "10.55", "10.56", "10.57", "10.58", "10.59", "10.60", "10.6", "10.61", "10.62", "10.63", "10.64", "10.65", "10.66", "10.67",
+	"10.68", "10.69", "10.70", "10.7", "10.71", "10.72", "10.73", "10.74", "10.75", "10.76", "10.77", "10.78", "10.79", "10.80", "10.8", "10.81",
+	"10.82", "10.83", "10.84", "10.85", "10.86", "10.87", "10.88", "10.89", "10.90", "10.9", "10.91", "10.92", "10.93", "10.94", "10.95", "10.96",
+	"10.97", "10.98", "10.99", "11.00", "11.0", "11.01", "11.02", "11.03", "11.04", "11.05", "11.06", "11.07", "11.08", "11.09", "11.10", "11.1",
+	"11.11", "11.12", "11.13", "11.14", "11.15", "11.16", "11.17", "11.18", "11.19", "11.20", "11.2", "11.21", "11.22", "11.23", "11.24", "11.25",
+	"11.26", "11.27", "11.28", "11.29", "11.30", "11.3", "11.31", "11.32", "11.33", "11.34", "11.35", "11.36", "11.37", "11.38", "11.39", "11.40",
+	"11.4", "11.41", "11.42", "11.43", "11.44", "11.45", "11.46", "11.47", "11.48", "11.49", "11.50", "11.5", "11.51", "11.52", "11.53", "11.54",
+	"11.55", "11.56", "11.57", "11.58", "11.59", "11.60", "11.6", "11.61", "11.62", "11.63", "11.64", "11.65", "11.66", "11.67", "11.68", "11.69",
+	"11.70", "11.7", "11.71", "11.72", "11.73", "11.74", "11.75", "11.76", "11.77", "11.78", "11.79", "11.80", "11.8", "11.81", "11.82", "11.83",
+	"11.84", "11.85", "11.86", "11.87", "11.88", "11.89", "11.90", "11.9", "11.91", "11.92", "11.93", "11.94", "11.95", "11.96", "11.97", "11.98",
+	"11.99", "12.00", "12.0", "12.01", "12.02", "12.03", "12.04", "12.05", "12.06", "12.07", "12.08", "12.09", "12.10", "12.1", "12.11", "12.12",
+	"12.13", "12.14", "12.15", "12.16", "12.17", "12.18", "12.19", "12.20", "12.2", "12.21", "12.22", "12.23", "12.24", "12.25", "12.26", "12.27",
+	"12.28", "12.29", "12.30", "12.3", "12.31", "12.32", "12.33", "12.34", "12.35", "12.36", "12.37", "12.38", "12.39", "12.40", "12.4", "12.41",
+	"12.42", "12.43", "12.44", "12.45", "12.46", "12.47", "12.48", "12.49", "12.50", "12.5", "12.51", "12.52", "12.53", "12.54", "12.55", "12.56",
+	"12.57", "12.58", "12.59", "12.60", "12.6", "12.61", "12.62", "12.63", "12.64", "12.65", "12.66", "12.67", "12.68", "12.69", "12.70", "12.7",
+	"12.71", "12.72", "12.73", "12.74", "12.75", "12.76", "12.77", "12.78", "12.79", "12.80", "12.8", "12.81", "12.82", "12.83", "12.84", "12.85",
+	"12.86", "12.87", "12.88", "12.89", "12.90", "12.9", "12.91", "12.92", "12.93", "12.94", "12.95", "12.96", "12.97", "12.98", "12.99", "13.00",
+	"13.0", "13.01", "13.02", "13.03", "13.04", "13.05", "13.06", "13.07", "13.08", "13.09", "13.10", "13.1", "13.11", "13.12", "13.13", "13.14",
+	"13.15", "13.16", "13.17", "13.18", "13.19", "13.20", "13.2", "13.21", "13.22", "13.23", "13.24", "13.25", "13.26", "13.27", "13.28", "13.29",
+	"13.30", "13.3", "13.31", "13.32", "13.33", "13.34", "13.35", "13.36", "13.37", "13.38", "13.39", "13.40", "13.4", "13.41", "13.42", "13.43",
+	"13.44", "13.45", "13.46", "13.47", "13.48", "13.49", "13.50", "13.5", "13.51", "13.52", "13.53", "13.54", "13.55", "13.56", "13.57", "13.58",
+	"13.59", "13.60", "13.6", "13.61", "13.62", "13.63", "13.64", "13.65", "13.66", "13.67", "13.68", "13.69", "13.70", "13.7", "13.71", "13.72",
+	"13.73", "13.74", "13.75", "13.76", "13.77", "13.78", "13.79", "13.80", "13.8", "13.81", "13.82", "13.83", "13.84", "13.85", "13.86", "13.87",
+	"13.88", "13.89", "13.90", "13.9", "13.91", "13.92", "13.93", "13.94", "13.95", "13.96", "13.97", "13.98", "13.99", "14.00", "14.0", "14.01",
+	"14.02", "14.03", "14.04", "14.05", "14.06", "14.07", "14.08", "14.09", "14.10", "14.1", "14.11", "14.12", "14.13", "14.14", "14.15", "14.16",
+	"14.17", "14.18", "14.19", "14.20", "14.2", "14.21", "14.22", "14.23", "14.24", "14.25", "14.26", "14.27", "14.28", "14.29", "14.30", "14.3",
+	"14.31", "14.32", "14.33", "14.34", "14.35", "14.36", "14.37", "14.38", "14.39", "14.40", "14.4", "14.41", "14.42", "14.43", "14.44", "14.45",
+	"14.46", "14.47", "14.48", "14.49", "14.50", "14.5", "14.51", "14.52", "14.53", "14.54", "14.55", "14.56", "14.57", "14.58", "14.59", "14.60",
+	"14.6", "14.61", "14.62", "14.63", "14.64", "14.65", "14.66", "14.67", "14.68", "14.69", "14.70", "14.7", "14.71", "14.72", "14.73", "14.74",
+	"14.75", "14.76", "14.77", "14.78", "14.79", "14.80", "14.8", "14.81", "14.82", "14.83", "14.84", "14.85", "14.86", "14.87", "14.88", "14.89",
+	"14.90", "14.9", "14.91", "14.92", "14.93", "14.94", "14.95", "14.96", "14.97", "14.98", "14.99", "15.00", "15.0", "15.01", "15.02", "15.03",
+	"15.04", "15.05", "15.06", "15.07", "15.08", "15.09", "15.10", "15.1", "15.11", "15.12", "15.13", "15.14", "15.15", "15.16", "15.17", "15.18",
+	"15.19", "15.20", "15.2", "15.21", "15.22", "15.23", "15.24", "15.25", "15.26", "15.27", "15.28", "15.29", "15.30", "15.3", "15.31", "15.32",
+	"15.33", "15.34", "15.35", "15.36", "15.37", "15.38", "15.39", "15.40", "15.4", "15.41", "15.42", "15.43", "15.44", "15.45", "15.46", "15.47",
+	"15.48", "15.49", "15.50", "15.5", "15.51", "15.52", "15.53", "15.54", "15.55", "15.56", "15.57", "15.58", "15.59", "15.60", "15.6", "15.61",
+	"15.62", "15.63", "15.64", "15.65", "15.66", "15.67", "15.68", "15.69", "15.70", "15.7", "15.71", "15.72", "15.73", "15.74", "15.75", "15.76",
+	"15.77", "15.78", "15.79", "15.80", "15.8", "15.81", "15.82", "15.83", "15.84", "15.85", "15.86", "15.87", "15.88", "15.89", "15.90", "15.9",
+	"15.91", "15.92", "15.93", "15.94", "15.95", "15.96", "15.97", "15.98", "15.99", "16.00", "16.0", "16.01", "16.02", "16.03", "16.04", "16.05",
+	"16.06", "16.07", "16.08", "16.09", "16.10", "16.1", "16.11", "16.12", "16.13", "16.14", "16.15", "16.16", "16.17", "16.18", "16.19", "16.20",
+	"16.2", "16.21", "16.22", "16.23", "16.24", "16.25", "16.26", "16.27", "16.28", "16.29", "16.30", "16.3", "16.31", "16.32", "16.33", "16.34",
+	"16.35", "16.36", "16.37", "16.38", "16.39", "16.40", "16.4", "16.41", "16.42", "16.43", "16.44", "16.45", "16.46", "16.47", "16.48", "16.49",
+	"16.50", "16.5", "16.51", "16.52", "16.53", "16.54", "16.55", "16.56", "16.57", "16.58", "16.59", "16.60", "16.6", "16.61", "16.62", "16.63",
+	"16.64", "16.65", "16.66", "16.67", "16.68", "16.69", "16.70", "16.7", "16.71", "16.72", "16.73", "16.74", "16.75", "16.76", "16.77", "16.78",
+	"16.79", "16.80", "16.8", "16.81", "16.82", "16.83", "16.84", "16.85", "16.86", "16.87", "16.88", "16.89", "16.90", "16.9", "16.91", "16.92",
+	"16.93", "16.94", "16.95", "16.96", "16.97", "16.98", "16.99", "17.00", "17.0", "17.01", "17.02", "17.03", "17.04", "17.05", "17.06", "17.07",
+	"17.08", "17.09", "17.10", "17.1", "17.11", "17.12", "17.13", "17.14", "17.15", "17.16", "17.17", "17.18", "17.19", "17.20", "17.2", "17.21",
+	"17.22", "17.23", "17.24", "17.25", "17.26", "17.27", "17.28", "17.29", "17.30", "17.3", "17.31", "17.32", "17.33", "17.34", "17.35", "17.36",
+	"17.37", "17.38", "17.39", "17.40", "17.4", "17.41", "17.42", "17.43", "17.44", "17.45", "17.46", "17.47", "17.48", "17.49", "17.50", "17.5",
+	"17.51", "17.52", "17.53", "17.54", "17.55", "17.56", "17.57", "17.58", "17.59", "17.60", "17.6", "17.61", "17.62", "17.63", "17.64", "17.65",
+	"17.66", "17.67", "17.68", "17.69", "17.70", "17.7", "17.71", "17.72", "17.73", "17.74", "17.75", "17.76", "17.77", "17.78", "17.79", "17.80",
+	"17.8", "17.81", "17.82", "17.83", "17.84", "17.85", "17.86", "17.87", "17.88", "17.89", "17.90", "17.9", "17.91", "17.92", "17.93", "17.94",
+	"17.95", "17.96", "17.97", "17.98", "17.99", "18.00", "18.0", "18.01", "18.02", "18.03", "18.04", "18.05", "18.06", "18.07", "18.08", "18.09",
+	"18.10", "18.1", "18.11", "18.12", "18.13", "18.14", "18.15", "18.16", "18.17", "18.18", "18.19", "18.20", "18.2", "18.21", "18.22", "18.23",
+	"18.24", "18.25", "18.26", "18.27", "18.28", "18.29", "18.30", "18.3", "18.31", "18.32", "18.33", "18.34", "18.35", "18.36", "18.37", "18.38",
+	"18.39", "18.40", "18.4", "18.41", "18.42", "18.43", "18.44", "18.45", "18.46", "18.47", "18.48", "18.49", "18.50", "18.5", "18.51", "18.52",
+	"18.53", "18.54", "18.55", "18.56", "18.57", "18.58", "18.59", "18.60", "18.6", "18.61", "18.62", "18.63", "18.64", "18.65", "18.66", "18.67",
+	"18.68", "18.69", "18.70", "18.7", "18.71", "18.72", "18.73", "18.74", "18.75", "18.76", "18.77", "18.78", "18.79", "18.80", "18.8", "18.81",
+	"18.82", "18.83", "18.84", "18.85", "18.86", "18.87", "18.88", "18.89", "18.90", "18.9", "18.91", "18.92", "18.93", "18.94", "18.95", "18.96",
+	"18.97", "18.98", "18.99", "19.00", "19.0", "19.01", "19.02", "19.03", "19.04", "19.05", "19.06", "19.07", "19.08", "19.09", "19.10", "19.1",
+	"19.11", "19.12", "19.13", "19.14", "19.15", "19.16", "19.17", "19.18", "19.19", "19.20", "19.2", "19.21", "19.22", "19.23", "19.24", "19.25",
+	"19.26", "19.27", "19.28", "19.29", "19.30", "19.3", "19.31", "19.32", "19.33", "19.34", "19.35", "19.36", "19.37", "19.38", "19.39", "19.40",
+	"19.4", "19.41", "19.42", "19.43", "19.44", "19.45", "19.46", "19.47", "19.48", "19.49", "19.50", "19.5", "19.51", "19.52", "19.53", "19.54",
+	"19.55", "19.56", "19.57", "19.58", "19.59", "19.60", "19.6", "19.61", "19.62", "19.63", "19.64", "19.65", "19.66", "19.67", "19.68", "19.69",
+	"19.70", "19.7", "19.71", "19.72", "19.73", "19.74", "19.75", "19.76", "19.77", "19.78", "19.79", "19.80", "19.8", "19.81", "19.82", "19.83",
+	"19.84", "19.85", "19.86", "19.87", "19.88", "19.89", "19.90", "19.9", "19.91", "19.92", "19.93", "19.94", "19.95", "19.96", "19.97", "19.98",
+	"19.99", "20.00", "20.0", "20.01", "20.02", "20.03", "20.04", "20.05", "20.06", "20.07", "20.08", "20.09", "20.10", "20.1", "20.11", "20.12",
+	"20.13", "20.14", "20.15", "20.16", "20.17", "20.18", "20.19", "20.20", "20.2", "20.21", "20.22", "20.23", "20.24", "20.25", "20.26", "20.27",
+	"20.28", "20.29", "20.30", "20.3", "20.31", "20.32", "20.33", "20.34", "20.35", "20.36", "20.37", "20.38", "20.39", "20.40", "20.4", "20.41",
+	"20.42", "20.43", "20.44", "20.45", "20.46", "20.47", "20.48", "20.49", "20.50", "20.5", "20.51", "20.52", "20.53", "20.54", "20.55", "20.56",
+	"20.57", "20.58", "20.59", "20.60", "20.6", "20.61", "20.62", "20.63", "20.64", "20.65", "20.66", "20.67", "20.68", "20.69", "20.70", "20.7",
+	"20.71", "20.72", "20.73", "20.74", "20.75", "20.76", "20.77", "20.78", "20.79", "20.80", "20.8", "20.81", "20.82", "20.83", "20.84", "20.85",
+	"20.86", "20.87", "20.88", "20.89", "20.90", "20.9", "20.91", "20.92", "20.93", "20.94", "20.95", "20.96", "20.97", "20.98", "20.99", "21.00",
+	"21.0", "21.01", "21.02", "21.03", "21.04", "21.05", "21.06", "21.07", "21.08", "21.09", "21.10", "21.1", "21.11", "21.12", "21.13", "21.14",
+	"21.15", "21.16", "21.17", "21.18", "21.19", "21.20", "21.2", "21.21", "21.22", "21.23", "21.24", "21.25", "21.26", "21.27", "21.28", "21.29",
+	"21.30", "21.3", "21.31", "21.32", "21.33", "21.34", "21.35", "21.36", "21.37", "21.38", "21.39", "21.40", "21.4", "21.41", "21.42", "21.43",
+	"21.44", "21.45", "21.46", "21.47", "21.48", "21.49", "21.50", "21.5", "21.51", "21.52", "21.53", "21.54", "21.55", "21.56", "21.57", "21.58",
+	"21.59", "21.60", "21.6", "21.61", "21.62", "21.63", "21.64", "21.65", "21.66", "21.67", "21.68", "21.69", "21.70", "21.7", "21.71", "21.72",
+	"21.73", "21.74", "21.75", "21.76", "21.77", "21.78", "21.79", "21.80", "21.8", "21.81", "21.82", "21.83", "21.84", "21.85", "21.86", "21.87",
+	"21.88", "21.89", "21.90", "21.9", "21.91", "21.92", "21.93", "21.94", "21.95", "21.96", "21.97", "21.98", "21.99", "22.00", "22.0", "22.01",
+	"22.02", "22.03", "22.04", "22.05", "22.06", "22.07", "22.08", "22.09", "22.10", "22.1", "22.11", "22.12", "22.13", "22.14", "22.15", "22.16",
+	"22.17", "22.18", "22.19", "22.20", "22.2", "22.21", "22.22", "22.23", "22.24", "22.25", "22.26", "22.27", "22.28", "22.29", "22.30", "22.3",
+	"22.31", "22.32", "22.33", "22.34", "22.35", "22.36", "22.37", "22.38", "22.39", "22.40", "22.4", "22.41", "22.42", "22.43", "22.44", "22.45",
+	"22.46", "22.47", "22.48", "22.49", "22.50", "22.5", "22.51", "22.52", "22.53", "22.54", "22.55", "22.56", "22.57", "22.58", "22.59", "22.60",
+	"22.6", "22.61", "22.62", "22.63", "22.64", "22.65", "22.66", "22.67", "22.68", "22.69", "22.70", "22.7", "22.71", "22.72", "22.73", "22.74",
+	"22.75", "22.76", "22.77", "22.78", "22.79", "22.80", "22.8", "22.81", "22.82", "22.83", "22.84", "22.85", "22.86", "22.87", "22.88", "22.89",
+	"22.90", "22.9", "22.91", "22.92", "22.93", "22.94", "22.95", "22.96", "22.97", "22.98", "22.99", "23.00", "23.0", "23.01", "23.02", "23.03",
+	"23.04", "23.05", "23.06", "23.07", "23.08", "23.09", "23.10", "23.1", "23.11", "23.12", "23.13", "23.14", "23.15", "23.16", "23.17", "23.18",
+	"23.19", "23.20", "23.2", "23.21", "23.22", "23.23", "23.24", "23.25", "23.26", "23.27", "23.28", "23.29", "23.30", "23.3", "23.31", "23.32",
+	"23.33", "23.34", "23.35", "23.36", "23.37", "23.38", "23.39", "23.40", "23.4", "23.41", "23.42", "23.43", "23.44", "23.45", "23.46", "23.47",
+	"23.48", "23.49", "23.50", "23.5", "23.51", "23.52", "23.53", "23.54", "23.55", "23.56", "23.57", "23.58", "23.59", "23.60", "23.6", "23.61",
+	"23.62", "23.63", "23.64", "23.65", "23.66", "23.67", "23.68", "23.69", "23.70", "23.7", "23.71", "23.72", "23.73", "23.74", "23.75", "23.76",
+	"23.77", "23.78", "23.79", "23.80", "23.8", "23.81", "23.82", "23.83", "23.84", "23.85", "23.86", "23.87", "23.88", "23.89", "23.90", "23.9",
+	"23.91", "23.92", "23.93", "23.94", "23.95", "23.96", "23.97", "23.98", "23.99", "24.00", "24.0", "24.01", "24.02", "24.03", "24.04", "24.05",
+	"24.06", "24.07", "24.08", "24.09", "24.10", "24.1", "24.11", "24.12", "24.13", "24.14", "24.15", "24.16", "24.17", "24.18", "24.19", "24.20",
+	"24.2", "24.21", "24.22", "24.23", "24.24", "24.25", "24.26", "24.27", "24.28", "24.29", "24.30", "24.3", "24.31", "24.32", "24.33", "24.34",
+	"24.35", "24.36", "24.37", "24.38", "24.39", "24.40", "24.4", "24.41", "24.42", "24.43", "24.44", "24.45", "24.46", "24.47", "24.48", "24.49",
+	"24.50", "24.5", "24.51", "24.52", "24.53", "24.54", "24.55", "24.56", "24.57", "24.58", "24.59", "24.60", "24.6", "24.61", "24.62", "24.63",
+	"24.64", "24.65", "24.66", "24.67", "24.68", "24.69", "24.70", "24.7", "24.71", "24.72", "24.73", "24.74", "24.75", "24.76", "24.77", "24.78",
+	"24.79", "24.80", "24.8", "24.81", "24.82", "24.83", "24.84", "24.85", "24.86", "24.87", "24.88", "24.89", "24.90", "24.9", "24.91", "24.92",
+	"24.93", "24.94", "24.95", "24.96", "24.97", "24.98", "24.99", "25.00", "25.0", "25.01", "25.02", "25.03", "25.04", "25.05", "25.06", "25.07",
+	"25.08", "25.09", "25.10", "25.1", "25.11", "25.12", "25.13", "25.14", "25.15", "25.16", "25.17", "25.18", "25.19", "25.20", "25.2", "25.21",
+	"25.22", "25.23", "25.24", "25.25", "25.26", "25.27", "25.28", "25.29", "25.30", "25.3", "25.31", "25.32", "25.33", "25.34", "25.35", "25.36",
+	"25.37", "25.38", "25.39", "25.40", "25.4", "25.41", "25.42", "25.43", "25.44", "25.45", "25.46", "25.47", "25.48", "25.49", "25.50", "25.5",
+	"25.51", "25.52", "25.53", "25.54", "25.55", "25.56", "25.57", "25.58", "25.59", "25.60", "25.6", "25.61", "25.62", "25.63", "25.64", "25.65",
+	"25.66", "25.67", "25.68", "25.69", "25.70", "25.7", "25.71", "25.72", "25.73", "25.74", "25.75", "25.76", "25.77", "25.78", "25.79", "25.80",
+	"25.8", "25.81", "25.82", "25.83", "25.84", "25.85", "25.86", "25.87", "25.88", "25.89", "25.90", "25.9", "25.91", "25.92", "25.93", "25.94",
+	"25.95", "25.96", "25.97", "25.98", "25.99", "26.00", "26.0", "26.01", "26.02", "26.03", "26.04", "26.05", "26.06", "26.07", "26.08", "26.09",
+	"26.10", "26.1", "26.11", "26.12", "26.13", "26.14", "26.15", "26.16", "26.17", "26.18", "26.19", "26.20", "26.2", "26.21", "26.22", "26.23",
+	"26.24", "26.25", "26.26", "26.27", "26.28", "26.29", "26.30", "26.3", "26.31", "26.32", "26.33", "26.34", "26.35", "26.36", "26.37", "26.38",
+	"26.39", "26.40", "26.4", "26.41", "26.42", "26.43", "26.44", "26.45", "26.46", "26.47", "26.48", "26.49", "26.50", "26.5", "26.51", "26.52",
+	"26.53", "26.54", "26.55", "26.56", "26.57", "26.58", "26.59", "26.60", "26.6", "26.61", "26.62", "26.63", "26.64", "26.65", "26.66", "26.67",
+	"26.68", "26.69", "26.70", "26.7", "26.71", "26.72", "26.73", "26.74", "26.75", "26.76", "26.77", "26.78", "26.79", "26.80", "26.8", "26.81",
+	"26.82", "26.83", "26.84", "26.85", "26.86", "26.87", "26.88", "26.89", "26.90", "26.9", "26.91", "26.92", "26.93", "26.94", "26.95", "26.96",
+	"26.97", "26.98", "26.99", "27.00", "27.0", "27.01", "27.02", "27.03", "27.04", "27.05", "27.06", "27.07", "27.08", "27.09", "27.10", "27.1",
+	"27.11", "27.12", "27.13", "27.14", "27.15", "27.16", "27.17", "27.18", "27.19", "27.20", "27.2", "27.21", "27.22", "27.23", "27.24", "27.25",
+	"27.26", "27.27", "27.28", "27.29", "27.30", "27.3", "27.31", "27.32", "27.33", "27.34", "27.35", "27.36", "27.37", "27.38", "27.39", "27.40",
+	"27.4", "27.41", "27.42", "27.43", "27.44", "27.45", "27.46", "27.47", "27.48", "27.49", "27.50", "27.5", "27.51", "27.52", "27.53", "27.54",
+	"27.55", "27.56", "27.57", "27.58", "27.59", "27.60", "27.6", "27.61", "27.62", "27.63", "27.64", "27.65", "27.66", "27.67", "27.68", "27.69",
+	"27.70", "27.7", "27.71", "27.72", "27.73", "27.74", "27.75", "27.76", "27.77", "27.78", "27.79", "27.80", "27.8", "27.81", "27.82", "27.83",
+	"27.84", "27.85", "27.86", "27.87", "27.88", "27.89", "27.90", "27.9", "27.91", "27.92", "27.93", "27.94", "27.95", "27.96", "27.97", "27.98",
+	"27.99", "28.00", "28.0", "28.01", "28.02", "28.03", "28.04", "28.05", "28.06", "28.07", "28.08", "28.09", "28.10", "28.1", "28.11", "28.12",
+	"28.13", "28.14", "28.15", "28.16", "28.17", "28.18", "28.19", "28.20", "28.2", "28.21", "28.22", "28.23", "28.24", "28.25", "28.26", "28.27",
+	"28.28", "28.29", "28.30", "28.3", "28.31", "28.32", "28.33", "28.34", "28.35", "28.36", "28.37", "28.38", "28.39", "28.40", "28.4", "28.41",
+	"28.42", "28.43", "28.44", "28.45", "28.46", "28.47", "28.48", "28.49", "28.50", "28.5", "28.51", "28.52", "28.53", "28.54", "28.55", "28.56",
+	"28.57", "28.58", "28.59", "28.60", "28.6", "28.61", "28.62", "28.63", "28.64", "28.65", "28.66", "28.67", "28.68", "28.69", "28.70", "28.7",
+	"28.71", "28.72", "28.73", "28.74", "28.75", "28.76", "28.77", "28.78", "28.79", "28.80", "28.8", "28.81", "28.82", "28.83", "28.84", "28.85",
+	"28.86", "28.87", "28.88", "28.89", "28.90", "28.9", "28.91", "28.92", "28.93", "28.94", "28.95", "28.96", "28.97", "28.98", "28.99", "29.00",
+	"29.0", "29.01", "29.02", "29.03", "29.04", "29.05", "29.06", "29.07", "29.08", "29.09", "29.10", "29.1", "29.11", "29.12", "29.13", "29.14",
+	"29.15", "29.16", "29.17", "29.18", "29.19", "29.20", "29.2", "29.21", "29.22", "29.23", "29.24", "29.25", "29.26", "29.27", "29.28", "29.29",
+	"29.30", "29.3", "29.31", "29.32", "29.33", "29.34", "29.35", "29.36", "29.37", "29.38", "29.39", "29.40", "29.4", "29.41", "29.42", "29.43",
+	"29.44", "29.45", "29.46", "29.47", "29.48", "29.49", "29.50", "29.5", "29.51", "29.52", "29.53", "29.54", "29.55", "29.56", "29.57", "29.58",
+	"29.59", "29.60", "29.6", "29.61", "29.62", "29.63", "29.64", "29.65", "29.66", "29.67", "29.68", "29.69", "29.70", "29.7", "29.71", "29.72",
+	"29.73", "29.74", "29.75", "29.76", "29.77", "29.78", "29.79", "29.80", "29.8", "29.81", "29.82", "29.83", "29.84", "29.85", "29.86", "29.87",
+	"29.88", "29.89", "29.90", "29.9", "29.91", "29.92", "29.93", "29.94", "29.95", "29.96", "29.97", "29.98", "29.99", "30.00", "30.0", "30.01",
+	"30.02", "30.03", "30.04", "30.05", "30.06", "30.07", "30.08", "30.09", "30.10", "30.1", "30.11", "30.12", "30.13", "30.14", "30.15", "30.16",
+	"30.17", "30.18", "30.19", "30.20", "30.2", "30.21", "30.22", "30.23", "30.24", "30.25", "30.26", "30.27", "30.28", "30.29", "30.30", "30.3",
+	"30.31", "30.32", "30.33", "30.34", "30.35", "30.36", "30.37", "30.38", "30.39", "30.40", "30.4", "30.41", "30.42", "30.43", "30.44", "30.45",
+	"30.46", "30.47", "30.48", "30.49", "30.50", "30.5", "30.51", "30.52", "30.53", "30.54", "30.55", "30.56", "30.57", "30.58", "30.59", "30.60",
+	"30.6", "30.61", "30.62", "30.63", "30.64", "30.65", "30.66", "30.67", "30.68", "30.69", "30.70", "30.7", "30.71", "30.72", "30.73", "30.74",
+	"30.75", "30.76", "30.77", "30.78", "30.79", "30.80", "30.8", "30.81", "30.82", "30.83", "30.84", "30.85", "30.86", "30.87", "30.88", "30.89",
+	"30.90", "30.9", "30.91", "30.92", "30.93", "30.94", "30.95", "30.96", "30.97", "30.98", "30.99", "31.00", "31.0", "31.01", "31.02", "31.03",
+	"31.04", "31.05", "31.06", "31.07", "31.08", "31.09", "31.10", "31.1", "31.11", "31.12", "31.13", "31.14", "31.15", "31.16", "31.17", "31.18",
+	"31.19", "31.20", "31.2", "31.21", "31.22", "31.23", "31.24", "31.25", "31.26", "31.27", "31.28", "31.29", "31.30", "31.3", "31.31", "31.32",
+	"31.33", "31.34", "31.35", "31.36", "31.37", "31.38", "31.39", "31.40", "31.4", "31.41", "31.42", "31.43", "31.44", "31.45", "31.46", "31.47",
+	"31.48", "31.49", "31.50", "31.5", "31.51", "31.52", "31.53", "31.54", "31.55", "31.56", "31.57", "31.58", "31.59", "31.60", "31.6", "31.61",
+	"31.62", "31.63", "31.64", "31.65", "31.66", "31.67", "31.68", "31.69", "31.70", "31.7", "31.71", "31.72", "31.73", "31.74", "31.75", "31.76",
+	"31.77", "31.78", "31.79", "31.80", "31.8", "31.81", "31.82", "31.83", "31.84", "31.85", "31.86", "31.87", "31.88", "31.89", "31.90", "31.9",
+	"31.91", "31.92", "31.93", "31.94", "31.95", "31.96", "31.97", "31.98", "31.99", "32.00", "32.0", "32.01", "32.02", "32.03", "32.04", "32.05",
+	"32.06", "32.07", "32.08", "32.09", "32.10", "32.1", "32.11", "32.12", "32.13", "32.14", "32.15", "32.16", "32.17", "32.18", "32.19", "32.20",
+	"32.2", "32.21", "32.22", "32.23", "32.24", "32.25", "32.26", "32.27", "32.28", "32.29", "32.30", "32.3", "32.31", "32.32", "32.33", "32.34",
+	"32.35", "32.36", "32.37", "32.38", "32.39", "32.40", "32.4", "32.41", "32.42", "32.43", "32.44", "32.45", "32.46", "32.47", "32.48", "32.49",
+	"32.50", "32.5", "32.51", "32.52", "32.53", "32.54", "32.55", "32.56", "32.57", "32.58", "32.59", "32.60", "32.6", "32.61", "32.62", "32.63",
+	"32.64", "32.65", "32.66", "32.67", "32.68", "32.69", "32.70", "32.7", "32.71", "32.72", "32.73", "32.74", "32.75", "32.76", "32.77", "32.78",
+	"32.79", "32.80", "32.8", "32.81", "32.82", "32.83", "32.84", "32.85", "32.86", "32.87", "32.88", "32.89", "32.90", "32.9", "32.91", "32.92",
+	"32.93", "32.94", "32.95", "32.96", "32.97", "32.98", "32.99", "33.00", "33.0", "33.01", "33.02", "33.03", "33.04", "33.05", "33.06", "33.07",
+	"33.08", "33.09", "33.10", "33.1", "33.11", "33.12", "33.13", "33.14", "33.15", "33.16", "33.17", "33.18", "33.19", "33.20", "33.2", "33.21",
+	"33.22", "33.23", "33.24", "33.25", "33.26", "33.27", "33.28", "33.29", "33.30", "33.3", "33.31", "33.32", "33.33", "33.34", "33.35", "33.36",
+	"33.37", "33.38", "33.39", "33.40", "33.4", "33.41", "33.42", "33.43", "33.44", "33.45", "33.46", "33.47", "33.48", "33.49", "33.50", "33.5",
+	"33.51", "33.52", "33.53", "33.54", "33.55", "33.56", "33.57", "33.58", "33.59", "33.60", "33.6", "33.61", "33.62", "33.63", "33.64", "33.65",
+	"33.66", "33.67", "33.68", "33.69", "33.70", "33.7", "33.71", "33.72", "33.73", "33.74", "33.75", "33.76", "33.77", "33.78", "33.79", "33.80",
+	"33.8", "33.81", "33.82", "33.83", "33.84", "33.85", "33.86", "33.87", "33.88", "33.89", "33.90", "33.9", "33.91", "33.92", "33.93", "33.94",
+	"33.95", "33.96", "33.97", "33.98", "33.99", "34.00", "34.0", "34.01", "34.02", "34.03", "34.04", "34.05", "34.06", "34.07", "34.08", "34.09",
+	"34.10", "34.1", "34.11", "34.12", "34.13", "34.14", "34.15", "34.16", "34.17", "34.18", "34.19", "34.20", "34.2", "34.21", "34.22", "34.23",
+	"34.24", "34.25", "34.26", "34.27", "34.28", "34.29", "34.30", "34.3", "34.31", "34.32", "34.33", "34.34", "34.35", "34.36", "34.37", "34.38",
+	"34.39", "34.40", "34.4", "34.41", "34.42", "34.43", "34.44", "34.45", "34.46", "34.47", "34.48", "34.49", "34.50", "34.5", "34.51", "34.52",
+	"34.53", "34.54", "34.55", "34.56", "34.57", "34.58", "34.59", "34.60", "34.6", "34.61", "34.62", "34.63", "34.64", "34.65", "34.66", "34.67",
+	"34.68", "34.69", "34.70", "34.7", "34.71", "34.72", "34.73", "34.74", "34.75", "34.76", "34.77", "34.78", "34.79", "34.80", "34.8", "34.81",
+	"34.82", "34.83", "34.84", "34.85", "34.86", "34.87", "34.88", "34.89", "34.90", "34.9", "34.91", "34.92", "34.93", "34.94", "34.95", "34.96",
+	"34.97", "34.98", "34.99", "35.00", "35.0", "35.01", "35.02", "35.03", "35.04", "35.05", "35.06", "35.07", "35.08", "35.09", "35.10", "35.1",
+	"35.11", "35.12", "35.13", "35.14", "35.15", "35.16", "35.17", "35.18", "35.19", "35.20", "35.2", "35.21", "35.22", "35.23", "35.24", "35.25",
+	"35.26", "35.27", "35.28", "35.29", "35.30", "35.3", "35.31", "35.32", "35.33", "35.34", "35.35", "35.36", "35.37", "35.38", "35.39", "35.40",
+	"35.4", "35.41", "35.42", "35.43", "35.44", "35.45", "35.46", "35.47", "35.48", "35.49", "35.50", "35.5", "35.51", "35.52", "35.53", "35.54",
+	"35.55", "35.56", "35.57", "35.58", "35.59", "35.60", "35.6", "35.61", "35.62", "35.63", "35.64", "35.65", "35.66", "35.67", "35.68", "35.69",
+	"35.70", "35.7", "35.71", "35.72", "35.73", "35.74", "35.75", "35.76", "35.77", "35.78", "35.79", "35.80", "35.8", "35.81", "35.82", "35.83",
+	"35.84", "35.85", "35.86", "35.87", "35.88", "35.89", "35.90", "35.9", "35.91", "35.92", "35.93", "35.94", "35.95", "35.96", "35.97", "35.98",
+	"35.99", "36.00", "36.0", "36.01", "36.02", "36.03", "36.04", "36.05", "36.06", "36.07", "36.08", "36.09", "36.10", "36.1", "36.11", "36.12",
+	"36.13", "36.14", "36.15", "36.16", "36.17", "36.18", "36.19", "36.20", "36.2", "36.21", "36.22", "36.23", "36.24", "36.25", "36.26", "36.27",
+	"36.28", "36.29", "36.30", "36.3", "36.31", "36.32", "36.33", "36.34", "36.35", "36.36", "36.37", "36.38", "36.39", "36.40", "36.4", "36.41",
+	"36.42", "36.43", "36.44", "36.45", "36.46", "36.47", "36.48", "36.49", "36.50", "36.5", "36.51", "36.52", "36.53", "36.54", "36.55", "36.56",
+	"36.57", "36.58", "36.59", "36.60", "36.6", "36.61", "36.62", "36.63", "36.64", "36.65", "36.66", "36.67", "36.68", "36.69", "36.70", "36.7",
+	"36.71", "36.72", "36.73", "36.74", "36.75", "36.76", "36.77", "36.78", "36.79", "36.80", "36.8", "36.81", "36.82", "36.83", "36.84", "36.85",
+	"36.86", "36.87", "36.88", "36.89", "36.90", "36.9", "36.91", "36.92", "36.93", "36.94", "36.95", "36.96", "36.97", "36.98", "36.99", "37.00",
+	"37.0", "37.01", "37.02", "37.03", "37.04", "37.05", "37.06", "37.07", "37.08", "37.09", "37.10", "37.1", "37.11", "37.12", "37.13", "37.14",
+	"37.15", "37.16", "37.17", "37.18", "37.19", "37.20", "37.2", "37.21", "37.22", "37.23", "37.24", "37.25", "37.26", "37.27", "37.28", "37.29",
+	"37.30", "37.3", "37.31", "37.32", "37.33", "37.34", "37.35", "37.36", "37.37", "37.38", "37.39", "37.40", "37.4", "37.41", "37.42", "37.43",
+	"37.44", "37.45", "37.46", "37.47", "37.48", "37.49", "37.50", "37.5", "37.51", "37.52", "37.53", "37.54", "37.55", "37.56", "37.57", "37.58",
+	"37.59", "37.60", "37.6", "37.61", "37.62", "37.63", "37.64", "37.65", "37.66", "37.67", "37.68", "37.69", "37.70", "37.7", "37.71", "37.72",
+	"37.73", "37.74", "37.75", "37.76", "37.77", "37.78", "37.79", "37.80", "37.8", "37.81", "37.82", "37.83", "37.84", "37.85", "37.86", "37.87",
+	"37.88", "37.89", "37.90", "37.9", "37.91", "37.92", "37.93", "37.94", "37.95", "37.96", "37.97", "37.98", "37.99", "38.00", "38.0", "38.01",
+	"38.02", "38.03", "38.04", "38.05", "38.06", "38.07", "38.08", "38.09", "38.10", "38.1", "38.11", "38.12", "38.13", "38.14", "38.15", "38.16",
+	"38.17", "38.18", "38.19", "38.20", "38.2", "38.21", "38.22", "38.23", "38.24", "38.25", "38.26", "38.27", "38.28", "38.29", "38.30", "38.3",
+	"38.31", "38.32", "38.33", "38.34", "38.35", "38.36", "38.37", "38.38", "38.39", "38.40", "38.4", "38.41", "38.42", "38.43", "38.44", "38.45",
+	"38.46", "38.47", "38.48", "38.49", "38.50", "38.5", "38.51", "38.52", "38.53", "38.54", "38.55", "38.56", "38.57", "38.58", "38.59", "38.60",
+	"38.6", "38.61", "38.62", "38.63", "38.64", "38.65", "38.66", "38.67", "38.68", "38.69", "38.70", "38.7", "38.71", "38.72", "38.73", "38.74",
+	"38.75", "38.76", "38.77", "38.78", "38.79", "38.80", "38.8", "38.81", "38.82", "38.83", "38.84", "38.85", "38.86", "38.87", "38.88", "38.89",
+	"38.90", "38.9", "38.91", "38.92", "38.93", "38.94", "38.95", "38.96", "38.97", "38.98", "38.99", "39.00", "39.0", "39.01", "39.02", "39.03",
+	"39.04", "39.05", "39.06", "39.07", "39.08", "39.09", "39.10", "39.1", "39.11", "39.12", "39.13", "39.14", "39.15", "39.16", "39.17", "39.18",
+	"39.19", "39.20", "39.2", "39.21", "39.22", "39.23", "39.24", "39.25", "39.26", "39.27", "39.28", "39.29", "39.30", "39.3", "39.31", "39.32",
+	"39.33", "39.34", "39.35", "39.36", "39.37", "39.38", "39.39", "39.40", "39.4", "39.41", "39.42", "39.43", "39.44", "39.45", "39.46", "39.47",
+	"39.48", "39.49", "39.50", "39.5", "39.51", "39.52", "39.53", "39.54", "39.55", "39.56", "39.57", "39.58", "39.59", "39.60", "39.6", "39.61",
+	"39.62", "39.63", "39.64", "39.65", "39.66", "39.67", "39.68", "39.69", "39.70", "39.7", "39.71", "39.72", "39.73", "39.74", "39.75", "39.76",
+	"39.77", "39.78", "39.79", "39.80", "39.8", "39.81", "39.82", "39.83", "39.84", "39.85", "39.86", "39.87", "39.88", "39.89", "39.90", "39.9",
+	"39.91", "39.92", "39.93", "39.94", "39.95", "39.96", "39.97", "39.98", "39.99", "40.00", "40.0", "40.01", "40.02", "40.03", "40.04", "40.05",
+	"40.06", "40.07", "40.08", "40.09", "40.10", "40.1", "40.11", "40.12", "40.13", "40.14", "40.15", "40.16", "40.17", "40.18", "40.19", "40.20",
+	"40.2", "40.21", "40.22", "40.23", "40.24", "40.25", "40.26", "40.27", "40.28", "40.29", "40.30", "40.3", "40.31", "40.32", "40.33", "40.34",
+	"40.35", "40.36", "40.37", "40.38", "40.39", "40.40", "40.4", "40.41", "40.42", "40.43", "40.44", "40.45", "40.46", "40.47", "40.48", "40.49",
+	"40.50", "40.5", "40.51", "40.52", "40.53", "40.54", "40.55", "40.56", "40.57", "40.58", "40.59", "40.60", "40.6", "40.61", "40.62", "40.63",
+	"40.64", "40.65", "40.66", "40.67", "40.68", "40.69", "40.70", "40.7", "40.71", "40.72", "40.73", "40.74", "40.75", "40.76", "40.77", "40.78",
+	"40.79", "40.80", "40.8", "40.81", "40.82", "40.83", "40.84", "40.85", "40.86", "40.87", "40.88", "40.89", "40.90", "40.9", "40.91", "40.92",
+	"40.93", "40.94", "40.95", "40.96", "40.97", "40.98", "40.99", "41.00", "41.0", "41.01", "41.02", "41.03", "41.04", "41.05", "41.06", "41.07",
+	"41.08", "41.09", "41.10", "41.1", "41.11", "41.12", "41.13", "41.14", "41.15", "41.16", "41.17", "41.18", "41.19", "41.20", "41.2", "41.21",
+	"41.22", "41.23", "41.24", "41.25", "41.26", "41.27", "41.28", "41.29", "41.30", "41.3", "41.31", "41.32", "41.33", "41.34", "41.35", "41.36",
+	"41.37", "41.38", "41.39", "41.40", "41.4", "41.41", "41.42", "41.43", "41.44", "41.45", "41.46", "41.47", "41.48", "41.49", "41.50", "41.5",
+	"41.51", "41.52", "41.53", "41.54", "41.55", "41.56", "41.57", "41.58", "41.59", "41.60", "41.6", "41.61", "41.62", "41.63", "41.64", "41.65",
+	"41.66", "41.67", "41.68", "41.69", "41.70", "41.7", "41.71", "41.72", "41.73", "41.74", "41.75", "41.76", "41.77", "41.78", "41.79", "41.80",
+	"41.8", "41.81", "41.82", "41.83", "41.84", "41.85", "41.86", "41.87", "41.88", "41.89", "41.90", "41.9", "41.91", "41.92", "41.93", "41.94",
+	"41.95", "41.96", "41.97", "41.98", "41.99", "42.00", "42.0", "42.01", "42.02", "42.03", "42.04", "42.05", "42.06", "42.07", "42.08", "42.09",
+	"42.10", "42.1", "42.11", "42.12", "42.13", "42.14", "42.15", "42.16", "42.17", "42.18", "42.19", "42.20", "42.2", "42.21", "42.22", "42.23",
+	"42.24", "42.25", "42.26", "42.27", "42.28", "42.29", "42.30", "42.3", "42.31", "42.32", "42.33", "42.34", "42.35", "42.36", "42.37", "42.38",
+	"42.39", "42.40", "42.4", "42.41", "42.42", "42.43", "42.44", "42.45", "42.46", "42.47", "42.48", "42.49", "42.50", "42.5", "42.51", "42.52",
+	"42.53", "42.54", "42.55", "42.56", "42.57", "42.58", "42.59", "42.60", "42.6", "42.61", "42.62", "42.63", "42.64", "42.65", "42.66", "42.67",
+	"42.68", "42.69", "42.70", "42.7", "42.71", "42.72", "42.73", "42.74", "42.75", "42.76", "42.77", "42.78", "42.79", "42.80", "42.8", "42.81",
+	"42.82", "42.83", "42.84", "42.85", "42.86", "42.87", "42.88", "42.89", "42.90", "42.9", "42.91", "42.92", "42.93", "42.94", "42.95", "42.96",
+	"42.97", "42.98", "42.99", "43.00", "43.0", "43.01", "43.02", "43.03", "43.04", "43.05", "43.06", "43.07", "43.08", "43.09", "43.10", "43.1",
+	"43.11", "43.12", "43.13", "43.14", "43.15", "43.16", "43.17", "43.18", "43.19", "43.20", "43.2", "43.21", "43.22", "43.23", "43.24", "43.25",
+	"43.26", "43.27", "43.28", "43.29", "43.30", "43.3", "43.31", "43.32", "43.33", "43.34", "43.35", "43.36", "43.37", "43.38", "43.39", "43.40",
+	"43.4", "43.41", "43.42", "43.43", "43.44", "43.45", "43.46", "43.47", "43.48", "43.49", "43.50", "43.5", "43.51", "43.52", "43.53", "43.54",
+	"43.55", "43.56", "43.57", "43.58", "43.59", "43.60", "43.6", "43.61", "43.62", "43.63", "43.64", "43.65", "43.66", "43.67", "43.68", "43.69",
+	"43.70", "43.7", "43.71", "43.72", "43.73", "43.74", "43.75", "43.76", "43.77", "43.78", "43.79", "43.80", "43.8", "43.81", "43.82", "43.83",
+	"43.84", "43.85", "43.86", "43.87", "43.88", "43.89", "43.90", "43.9", "43.91", "43.92", "43.93", "43.94", "43.95", "43.96", "43.97", "43.98",
+	"43.99", "44.00", "44.0", "44.01", "44.02", "44.03", "44.04", "44.05", "44.06", "44.07", "44.08", "44.09", "44.10", "44.1", "44.11", "44.12",
+	"44.13", "44.14", "44.15", "44.16", "44.17", "44.18", "44.19", "44.20", "44.2", "44.21", "44.22", "44.23", "44.24", "44.25", "44.26", "44.27",
+	"44.28", "44.29", "44.30", "44.3", "44.31", "44.32", "44.33", "44.34", "44.35", "44.36", "44.37", "44.38", "44.39", "44.40", "44.4", "44.41",
+	"44.42", "44.43", "44.44", "44.45", "44.46", "44.47", "44.48", "44.49", "44.50", "44.5", "44.51", "44.52", "44.53", "44.54", "44.55", "44.56",
+	"44.57", "44.58", "44.59", "44.60", "44.6", "44.61", "44.62", "44.63", "44.64", "44.65", "44.66", "44.67", "44.68", "44.69", "44.70", "44.7",
+	"44.71", "44.72", "44.73", "44.74", "44.75", "44.76", "44.77", "44.78", "44.79", "44.80", "44.8", "44.81", "44.82", "44.83", "44.84", "44.85",
+	"44.86", "44.87", "44.88", "44.89", "44.90", "44.9", "44.91", "44.92", "44.93", "44.94", "44.95", "44.96", "44.97", "44.98", "44.99", "45.00",
+	"45.0", "45.01", "45.02", "45.03", "45.04", "45.05", "45.06", "45.07", "45.08", "45.09", "45.10", "45.1", "45.11", "45.12", "45.13", "45.14",
+	"45.15", "45.16", "45.17", "45.18", "45.19", "45.20", "45.2", "45.21", "45.22", "45.23", "45.24", "45.25", "45.26", "45.27", "45.28", "45.29",
+	"45.30", "45.3", "45.31", "45.32", "45.33", "45.34", "45.35", "45.36", "45.37", "45.38", "45.39", "45.40", "45.4", "45.41", "45.42", "45.43",
+	"45.44", "45.45", "45.46", "45.47", "45.48", "45.49", "45.50", "45.5", "45.51", "45.52", "45.53", "45.54", "45.55", "45.56", "45.57", "45.58",
+	"45.59", "45.60", "45.6", "45.61", "45.62", "45.63", "45.64", "45.65", "45.66", "45.67", "45.68", "45.69", "45.70", "45.7", "45.71", "45.72",
+	"45.73", "45.74", "45.75", "45.76", "45.77", "45.78", "45.79", "45.80", "45.8", "45.81", "45.82", "45.83", "45.84", "45.85", "45.86", "45.87",
+	"45.88", "45.89", "45.90", "45.9", "45.91", "45.92", "45.93", "45.94", "45.95", "45.96", "45.97", "45.98", "45.99", "46.00", "46.0", "46.01",
+	"46.02", "46.03", "46.04", "46.05", "46.06", "46.07", "46.08", "46.09", "46.10", "46.1", "46.11", "46.12", "46.13", "46.14", "46.15", "46.16",
+	"46.17", "46.18", "46.19", "46.20", "46.2", "46.21", "46.22", "46.23", "46.24", "46.25", "46.26", "46.27", "46.28", "46.29", "46.30", "46.3",
+	"46.31", "46.32", "46.33", "46.34", "46.35", "46.36", "46.37", "46.38", "46.39", "46.40", "46.4", "46.41", "46.42", "46.43", "46.44", "46.45",
+	"46.46", "46.47", "46.48", "46.49", "46.50", "46.5", "46.51", "46.52", "46.53", "46.54", "46.55", "46.56", "46.57", "46.58", "46.59", "46.60",
+	"46.6", "46.61", "46.62", "46.63", "46.64", "46.65", "46.66", "46.67", "46.68", "46.69", "46.70", "46.7", "46.71", "46.72", "46.73", "46.74",
+	"46.75", "46.76", "46.77", "46.78", "46.79", "46.80", "46.8", "46.81", "46.82", "46.83", "46.84", "46.85", "46.86", "46.87", "46.88", "46.89",
+	"46.90", "46.9", "46.91", "46.92", "46.93", "46.94", "46.95", "46.96", "46.97", "46.98", "46.99", "47.00", "47.0", "47.01", "47.02", "47.03",
+	"47.04", "47.05", "47.06", "47.07", "47.08", "47.09", "47.10", "47.1", "47.11", "47.12", "47.13", "47.14", "47.15", "47.16", "47.17", "47.18",
+	"47.19", "47.20", "47.2", "47.21", "47.22", "47.23", "47.24", "47.25", "47.26", "47.27", "47.28", "47.29", "47.30", "47.3", "47.31", "47.32",
+	"47.33", "47.34", "47.35", "47.36", "47.37", "47.38", "47.39", "47.40", "47.4", "47.41", "47.42", "47.43", "47.44", "47.45", "47.46", "47.47",
+	"47.48", "47.49", "47.50", "47.5", "47.51", "47.52", "47.53", "47.54", "47.55", "47.56", "47.57", "47.58", "47.59", "47.60", "47.6", "47.61",
+	"47.62", "47.63", "47.64", "47.65", "47.66", "47.67", "47.68", "47.69", "47.70", "47.7", "47.71", "47.72", "47.73", "47.74", "47.75", "47.76",
+	"47.77", "47.78", "47.79", "47.80", "47.8", "47.81", "47.82", "47.83", "47.84", "47.85", "47.86", "47.87", "47.88", "47.89", "47.90", "47.9",
+	"47.91", "47.92", "47.93", "47.94", "47.95", "47.96", "47.97", "47.98", "47.99", "48.00", "48.0", "48.01", "48.02", "48.03", "48.04", "48.05",
+	"48.06", "48.07", "48.08", "48.09", "48.10", "48.1", "48.11", "48.12", "48.13", "48.14", "48.15", "48.16", "48.17", "48.18", "48.19", "48.20",
+	"48.2", "48.21", "48.22", "48.23", "48.24", "48.25", "48.26", "48.27", "48.28", "48.29", "48.30", "48.3", "48.31", "48.32", "48.33", "48.34",
+	"48.35", "48.36", "48.37", "48.38", "48.39", "48.40", "48.4", "48.41", "48.42", "48.43", "48.44", "48.45", "48.46", "48.47", "48.48", "48.49",
+	"48.50", "48.5", "48.51", "48.52", "48.53", "48.54", "48.55", "48.56", "48.57", "48.58", "48.59", "48.60", "48.6", "48.61", "48.62", "48.63",
+	"48.64", "48.65", "48.66", "48.67", "48.68", "48.69", "48.70", "48.7", "48.71", "48.72", "48.73", "48.74", "48.75", "48.76", "48.77", "48.78",
+	"48.79", "48.80", "48.8", "48.81", "48.82", "48.83", "48.84", "48.85", "48.86", "48.87", "48.88", "48.89", "48.90", "48.9", "48.91", "48.92",
+	"48.93", "48.94", "48.95", "48.96", "48.97", "48.98", "48.99", "49.00", "49.0", "49.01", "49.02", "49.03", "49.04", "49.05", "49.06", "49.07",
+	"49.08", "49.09", "49.10", "49.1", "49.11", "49.12", "49.13", "49.14", "49.15", "49.16", "49.17", "49.18", "49.19", "49.20", "49.2", "49.21",
+	"49.22", "49.23", "49.24", "49.25", "49.26", "49.27", "49.28", "49.29", "49.30", "49.3", "49.31", "49.32", "49.33", "49.34", "49.35", "49.36",
+	"49.37", "49.38", "49.39", "49.40", "49.4", "49.41", "49.42", "49.43", "49.44", "49.45", "49.46", "49.47", "49.48", "49.49", "49.50", "49.5",
+	"49.51", "49.52", "49.53", "49.54", "49.55", "49.56", "49.57", "49.58", "49.59", "49.60", "49.6", "49.61", "49.62", "49.63", "49.64", "49.65",
+	"49.66", "49.67", "49.68", "49.69", "49.70", "49.7", "49.71", "49.72", "49.73", "49.74", "49.75", "49.76", "49.77", "49.78", "49.79", "49.80",
+	"49.8", "49.81", "49.82", "49.83", "49.84", "49.85", "49.86", "49.87", "49.88", "49.89", "49.90", "49.9", "49.91", "49.92", "49.93", "49.94",
+	"49.95", "49.96", "49.97", "49.98", "49.99", "50.00", "50.0", "50.01", "50.02", "50.03", "50.04", "50.05", "50.06", "50.07", "50.08", "50.09",
+	"50.10", "50.1", "50.11", "50.12", "50.13", "50.14", "50.15", "50.16", "50.17", "50.18", "50.19", "50.20", "50.2", "50.21", "50.22", "50.23",
+	"50.24", "50.25", "50.26", "50.27", "50.28", "50.29", "50.30", "50.3", "50.31", "50.32", "50.33", "50.34", "50.35", "50.36", "50.37", "50.38",
+	"50.39", "50.40", "50.4", "50.41", "50.42", "50.43", "50.44", "50.45", "50.46", "50.47", "50.48", "50.49", "50.50", "50.5", "50.51", "50.52",
+	"50.53", "50.54", "50.55", "50.56", "50.57", "50.58", "50.59", "50.60", "50.6", "50.61", "50.62", "50.63", "50.64", "50.65", "50.66", "50.67",
+	"50.68", "50.69", "50.70", "50.7", "50.71", "50.72", "50.73", "50.74", "50.75", "50.76", "50.77", "50.78", "50.79", "50.80", "50.8", "50.81",
+	"50.82", "50.83", "50.84", "50.85", "50.86", "50.87", "50.88", "50.89", "50.90", "50.9", "50.91", "50.92", "50.93", "50.94", "50.95", "50.96",
+	"50.97", "50.98", "50.99", "51.00", "51.0", "51.01", "51.02", "51.03", "51.04", "51.05", "51.06", "51.07", "51.08", "51.09", "51.10", "51.1",
+	"51.11", "51.12", "51.13", "51.14", "51.15", "51.16", "51.17", "51.18", "51.19", "51.20", "51.2", "51.21", "51.22", "51.23", "51.24", "51.25",
+	"51.26", "51.27", "51.28", "51.29", "51.30", "51.3", "51.31", "51.32", "51.33", "51.34", "51.35", "51.36", "51.37", "51.38", "51.39", "51.40",
+	"51.4", "51.41", "51.42", "51.43", "51.44", "51.45", "51.46", "51.47", "51.48", "51.49", "51.50", "51.5", "51.51", "51.52", "51.53", "51.54",
+	"51.55", "51.56", "51.57", "51.58", "51.59", "51.60", "51.6", "51.61", "51.62", "51.63", "51.64", "51.65", "51.66", "51.67", "51.68", "51.69",
+	"51.70", "51.7", "51.71", "51.72", "51.73", "51.74", "51.75", "51.76", "51.77", "51.78", "51.79", "51.80", "51.8", "51.81", "51.82", "51.83",
+	"51.84", "51.85", "51.86", "51.87", "51.88", "51.89", "51.90", "51.9", "51.91", "51.92", "51.93", "51.94", "51.95", "51.96", "51.97", "51.98",
+	"51.99", "52.00", "52.0", "52.01", "52.02", "52.03", "52.04", "52.05", "52.06", "52.07", "52.08", "52.09", "52.10", "52.1", "52.11", "52.12",
+	"52.13", "52.14", "52.15", "52.16", "52.17", "52.18", "52.19", "52.20", "52.2", "52.21", "52.22", "52.23", "52.24", "52.25", "52.26", "52.27",
+	"52.28", "52.29", "52.30", "52.3", "52.31", "52.32", "52.33", "52.34", "52.35", "52.36", "52.37", "52.38", "52.39", "52.40", "52.4", "52.41",
+	"52.42", "52.43", "52.44", "52.45", "52.46", "52.47", "52.48", "52.49", "52.50", "52.5", "52.51", "52.52", "52.53", "52.54", "52.55", "52.56",
+	"52.57", "52.58", "52.59", "52.60", "52.6", "52.61", "52.62", "52.63", "52.64", "52.65", "52.66", "52.67", "52.68", "52.69", "52.70", "52.7",
+	"52.71", "52.72", "52.73", "52.74", "52.75", "52.76", "52.77", "52.78", "52.79", "52.80", "52.8", "52.81", "52.82", "52.83", "52.84", "52.85",
+	"52.86", "52.87", "52.88", "52.89", "52.90", "52.9", "52.91", "52.92", "52.93", "52.94", "52.95", "52.96", "52.97", "52.98", "52.99", "53.00",
+	"53.0", "53.01", "53.02", "53.03", "53.04", "53.05", "53.06", "53.07", "53.08", "53.09", "53.10", "53.1", "53.11", "53.12", "53.13", "53.14",
+	"53.15", "53.16", "53.17", "53.18", "53.19", "53.20", "53.2", "53.21", "53.22", "53.23", "53.24", "53.25", "53.26", "53.27", "53.28", "53.29",
+	"53.30", "53.3", "53.31", "53.32", "53.33", "53.34", "53.35", "53.36", "53.37", "53.38", "53.39", "53.40", "53.4", "53.41", "53.42", "53.43",
+	"53.44", "53.45", "53.46", "53.47", "53.48", "53.49", "53.50", "53.5", "53.51", "53.52", "53.53", "53.54", "53.55", "53.56", "53.57", "53.58",
+	"53.59", "53.60", "53.6", "53.61", "53.62", "53.63", "53.64", "53.65", "53.66", "53.67", "53.68", "53.69", "53.70", "53.7", "53.71", "53.72",
+	"53.73", "53.74", "53.75", "53.76", "53.77", "53.78", "53.79", "53.80", "53.8", "53.81", "53.82", "53.83", "53.84", "53.85", "53.86", "53.87",
+	"53.88", "53.89", "53.90", "53.9", "53.91", "53.92", "53.93", "53.94", "53.95", "53.96", "53.97", "53.98", "53.99", "54.00", "54.0", "54.01",
+	"54.02", "54.03", "54.04", "54.05", "54.06", "54.07", "54.08", "54.09", "54.10", "54.1", "54.11", "54.12", "54.13", "54.14", "54.15", "54.16",
+	"54.17", "54.18", "54.19", "54.20", "54.2", "54.21", "54.22", "54.23", "54.24", "54.25", "54.26", "54.27", "54.28", "54.29", "54.30", "54.3",
+	"54.31", "54.32", "54.33", "54.34", "54.35", "54.36", "54.37", "54.38", "54.39", "54.40", "54.4", "54.41", "54.42", "54.43", "54.44", "54.45",
+	"54.46", "54.47", "54.48", "54.49", "54.50", "54.5", "54.51", "54.52", "54.53", "54.54", "54.55", "54.56", "54.57", "54.58", "54.59", "54.60",
+	"54.6", "54.61", "54.62", "54.63", "54.64", "54.65", "54.66", "54.67", "54.68", "54.69", "54.70", "54.7", "54.71", "54.72", "54.73", "54.74",
+	"54.75", "54.76", "54.77", "54.78", "54.79", "54.80", "54.8", "54.81", "54.82", "54.83", "54.84", "54.85", "54.86", "54.87", "54.88", "54.89",
+	"54.90", "54.9", "54.91", "54.92", "54.93", "54.94", "54.95", "54.96", "54.97", "54.98", "54.99", "55.00", "55.0", "55.01", "55.02", "55.03",
+	"55.04", "55.05", "55.06", "55.07", "55.08", "55.09", "55.10", "55.1", "55.11", "55.12", "55.13", "55.14", "55.15", "55.16", "55.17", "55.18",
+	"55.19", "55.20", "55.2", "55.21", "55.22", "55.23", "55.24", "55.25", "55.26", "55.27", "55.28", "55.29", "55.30", "55.3", "55.31", "55.32",
+	"55.33", "55.34", "55.35", "55.36", "55.37", "55.38", "55.39", "55.40", "55.4", "55.41", "55.42", "55.43", "55.44", "55.45", "55.46", "55.47",
+	"55.48", "55.49", "55.50", "55.5", "55.51", "55.52", "55.53", "55.54", "55.55", "55.56", "55.57", "55.58", "55.59", "55.60", "55.6", "55.61",
+	"55.62", "55.63", "55.64", "55.65", "55.66", "55.67", "55.68", "55.69", "55.70", "55.7", "55.71", "55.72", "55.73", "55.74", "55.75", "55.76",
+	"55.77", "55.78", "55.79", "55.80", "55.8", "55.81", "55.82", "55.83", "55.84", "55.85", "55.86", "55.87", "55.88", "55.89", "55.90", "55.9",
+	"55.91", "55.92", "55.93", "55.94", "55.95", "55.96", "55.97", "55.98", "55.99", "56.00", "56.0", "56.01", "56.02", "56.03", "56.04", "56.05",
+	"56.06", "56.07", "56.08", "56.09", "56.10", "56.1", "56.11", "56.12", "56.13", "56.14", "56.15", "56.16", "56.17", "56.18", "56.19", "56.20",
+	"56.2", "56.21", "56.22", "56.23", "56.24", "56.25", "56.26", "56.27", "56.28", "56.29", "56.30", "56.3", "56.31", "56.32", "56.33", "56.34",
+	"56.35", "56.36", "56.37", "56.38", "56.39", "56.40", "56.4", "56.41", "56.42", "56.43", "56.44", "56.45", "56.46", "56.47", "56.48", "56.49",
+	"56.50", "56.5", "56.51", "56.52", "56.53", "56.54", "56.55", "56.56", "56.57", "56.58", "56.59", "56.60", "56.6", "56.61", "56.62", "56.63",
+	"56.64", "56.65", "56.66", "56.67", "56.68", "56.69", "56.70", "56.7", "56.71", "56.72", "56.73", "56.74", "56.75", "56.76", "56.77", "56.78",
+	"56.79", "56.80", "56.8", "56.81", "56.82", "56.83", "56.84", "56.85", "56.86", "56.87", "56.88", "56.89", "56.90", "56.9", "56.91", "56.92",
+	"56.93", "56.94", "56.95", "56.96", "56.97", "56.98", "56.99", "57.00", "57.0", "57.01", "57.02", "57.03", "57.04", "57.05", "57.06", "57.07",
+	"57.08", "57.09", "57.10", "57.1", "57.11", "57.12", "57.13", "57.14", "57.15", "57.16", "57.17", "57.18", "57.19", "57.20", "57.2", "57.21",
+	"57.22", "57.23", "57.24", "57.25", "57.26", "57.27", "57.28", "57.29", "57.30", "57.3", "57.31", "57.32", "57.33", "57.34", "57.35", "57.36",
+	"57.37", "57.38", "57.39", "57.40", "57.4", "57.41", "57.42", "57.43", "57.44", "57.45", "57.46", "57.47", "57.48", "57.49", "57.50", "57.5",
+	"57.51", "57.52", "57.53", "57.54", "57.55", "57.56", "57.57", "57.58", "57.59", "57.60", "57.6", "57.61", "57.62", "57.63", "57.64", "57.65",
+	"57.66", "57.67", "57.68", "57.69", "57.70", "57.7", "57.71", "57.72", "57.73", "57.74", "57.75", "57.76", "57.77", "57.78", "57.79", "57.80",
+	"57.8", "57.81", "57.82", "57.83", "57.84", "57.85", "57.86", "57.87", "57.88", "57.89", "57.90", "57.9", "57.91", "57.92", "57.93", "57.94",
+	"57.95", "57.96", "57.97", "57.98", "57.99", "58.00", "58.0", "58.01", "58.02", "58.03", "58.04", "58.05", "58.06", "58.07", "58.08", "58.09",
+	"58.10", "58.1", "58.11", "58.12", "58.13", "58.14", "58.15", "58.16", "58.17", "58.18", "58.19", "58.20", "58.2", "58.21", "58.22", "58.23",
+	"58.24", "58.25", "58.26", "58.27", "58.28", "58.29", "58.30", "58.3", "58.31", "58.32", "58.33", "58.34", "58.35", "58.36", "58.37", "58.38",
+	"58.39", "58.40", "58.4", "58.41", "58.42", "58.43", "58.44", "58.45", "58.46", "58.47", "58.48", "58.49", "58.50", "58.5", "58.51", "58.52",
+	"58.53", "58.54", "58.55", "58.56", "58.57", "58.58", "58.59", "58.60", "58.6", "58.61", "58.62", "58.63", "58.64", "58.65", "58.66", "58.67",
+	"58.68", "58.69", "58.70", "58.7", "58.71", "58.72", "58.73", "58.74", "58.75", "58.76", "58.77", "58.78", "58.79", "58.80", "58.8", "58.81",
+	"58.82", "58.83", "58.84", "58.85", "58.86", "58.87", "58.88", "58.89", "58.90", "58.9", "58.91", "58.92", "58.93", "58.94", "58.95", "58.96",
+	"58.97", "58.98", "58.99", "59.00", "59.0", "59.01", "59.02", "59.03", "59.04", "59.05", "59.06", "59.07", "59.08", "59.09", "59.10", "59.1",
+	"59.11", "59.12", "59.13", "59.14", "59.15", "59.16", "59.17", "59.18", "59.19", "59.20", "59.2", "59.21", "59.22", "59.23", "59.24", "59.25",
+	"59.26", "59.27", "59.28", "59.29", "59.30", "59.3", "59.31", "59.32", "59.33", "59.34", "59.35", "59.36", "59.37", "59.38", "59.39", "59.40",
+	"59.4", "59.41", "59.42", "59.43", "59.44", "59.45", "59.46", "59.47", "59.48", "59.49", "59.50", "59.5", "59.51", "59.52", "59.53", "59.54",
+	"59.55", "59.56", "59.57", "59.58", "59.59", "59.60", "59.6", "59.61", "59.62", "59.63", "59.64", "59.65", "59.66", "59.67", "59.68", "59.69",
+	"59.70", "59.7", "59.71", "59.72", "59.73", "59.74", "59.75", "59.76", "59.77", "59.78", "59.79", "59.80", "59.8", "59.81", "59.82", "59.83",
+	"59.84", "59.85", "59.86", "59.87", "59.88", "59.89", "59.90", "59.9", "59.91", "59.92", "59.93", "59.94", "59.95", "59.96", "59.97", "59.98",
+	"59.99", "60.00", "60.0", "60.01", "60.02", "60.03", "60.04", "60.05", "60.06", "60.07", "60.08", "60.09", "60.10", "60.1", "60.11", "60.12",
+	"60.13", "60.14", "60.15", "60.16", "60.17", "60.18", "60.19", "60.20", "60.2", "60.21", "60.22", "60.23", "60.24", "60.25", "60.26", "60.27",
+	"60.28", "60.29", "60.30", "60.3", "60.31", "60.32", "60.33", "60.34", "60.35", "60.36", "60.37", "60.38", "60.39", "60.40", "60.4", "60.41",
+	"60.42", "60.43", "60.44", "60.45", "60.46", "60.47", "60.48", "60.49", "60.50", "60.5", "60.51", "60.52", "60.53", "60.54", "60.55", "60.56",
+	"60.57", "60.58", "60.59", "60.60", "60.6", "60.61", "60.62", "60.63", "60.64", "60.65", "60.66", "60.67", "60.68", "60.69", "60.70", "60.7",
+	"60.71", "60.72", "60.73", "60.74", "60.75", "60.76", "60.77", "60.78", "60.79", "60.80", "60.8", "60.81", "60.82", "60.83", "60.84", "60.85",
+	"60.86", "60.87", "60.88", "60.89", "60.90", "60.9", "60.91", "60.92", "60.93", "60.94", "60.95", "60.96", "60.97", "60.98", "60.99", "61.00",
+	"61.0", "61.01", "61.02", "61.03", "61.04", "61.05", "61.06", "61.07", "61.08", "61.09", "61.10", "61.1", "61.11", "61.12", "61.13", "61.14",
+	"61.15", "61.16", "61.17", "61.18", "61.19", "61.20", "61.2", "61.21", "61.22", "61.23", "61.24", "61.25", "61.26", "61.27", "61.28", "61.29",
+	"61.30", "61.3", "61.31", "61.32", "61.33", "61.34", "61.35", "61.36", "61.37", "61.38", "61.39", "61.40", "61.4", "61.41", "61.42", "61.43",
+	"61.44", "61.45", "61.46", "61.47", "61.48", "61.49", "61.50", "61.5", "61.51", "61.52", "61.53", "61.54", "61.55", "61.56", "61.57", "61.58",
+	"61.59", "61.60", "61.6", "61.61", "61.62", "61.63", "61.64", "61.65", "61.66", "61.67", "61.68", "61.69", "61.70", "61.7", "61.71", "61.72",
+	"61.73", "61.74", "61.75", "61.76", "61.77", "61.78", "61.79", "61.80", "61.8", "61.81", "61.82", "61.83", "61.84", "61.85", "61.86", "61.87",
+	"61.88", "61.89", "61.90", "61.9", "61.91", "61.92", "61.93", "61.94", "61.95", "61.96", "61.97", "61.98", "61.99", "62.00", "62.0", "62.01",
+	"62.02", "62.03", "62.04", "62.05", "62.06", "62.07", "62.08", "62.09", "62.10", "62.1", "62.11", "62.12", "62.13", "62.14", "62.15", "62.16",
+	"62.17", "62.18", "62.19", "62.20", "62.2", "62.21", "62.22", "62.23", "62.24", "62.25", "62.26", "62.27", "62.28", "62.29", "62.30", "62.3",
+	"62.31", "62.32", "62.33", "62.34", "62.35", "62.36", "62.37", "62.38", "62.39", "62.40", "62.4", "62.41", "62.42", "62.43", "62.44", "62.45",
+	"62.46", "62.47", "62.48", "62.49", "62.50", "62.5", "62.51", "62.52", "62.53", "62.54", "62.55", "62.56", "62.57", "62.58", "62.59", "62.60",
+	"62.6", "62.61", "62.62", "62.63", "62.64", "62.65", "62.66", "62.67", "62.68", "62.69", "62.70", "62.7", "62.71", "62.72", "62.73", "62.74",
+	"62.75", "62.76", "62.77", "62.78", "62.79", "62.80", "62.8", "62.81", "62.82", "62.83", "62.84", "62.85", "62.86", "62.87", "62.88", "62.89",
+	"62.90", "62.9", "62.91", "62.92", "62.93", "62.94", "62.95", "62.96", "62.97", "62.98", "62.99", "63.00", "63.0", "63.01", "63.02", "63.03",
+	"63.04", "63.05", "63.06", "63.07", "63.08", "63.09", "63.10", "63.1", "63.11", "63.12", "63.13", "63.14", "63.15", "63.16", "63.17", "63.18",
+	"63.19", "63.20", "63.2", "63.21", "63.22", "63.23", "63.24", "63.25", "63.26", "63.27", "63.28", "63.29", "63.30", "63.3", "63.31", "63.32",
+	"63.33", "63.34", "63.35", "63.36", "63.37", "63.38", "63.39", "63.40", "63.4", "63.41", "63.42", "63.43", "63.44", "63.45", "63.46", "63.47",
+	"63.48", "63.49", "63.50", "63.5", "63.51", "63.52", "63.53", "63.54", "63.55", "63.56", "63.57", "63.58", "63.59", "63.60", "63.6", "63.61",
+	"63.62", "63.63", "63.64", "63.65", "63.66", "63.67", "63.68", "63.69", "63.70", "63.7", "63.71", "63.72", "63.73", "63.74", "63.75", "63.76",
+	"63.77", "63.78", "63.79", "63.80", "63.8", "63.81", "63.82", "63.83", "63.84", "63.85", "63.86", "63.87", "63.88", "63.89", "63.90", "63.9",
+	"63.91", "63.92", "63.93", "63.94", "63.95", "63.96", "63.97", "63.98", "63.99", "64.00", "64.0", "64.01", "64.02", "64.03", "64.04", "64.05",
+	"64.06", "64.07", "64.08", "64.09", "64.10", "64.1", "64.11", "64.12", "64.13", "64.14", "64.15", "64.16", "64.17", "64.18", "64.19", "64.20",
+	"64.2", "64.21", "64.22", "64.23", "64.24", "64.25", "64.26", "64.27", "64.28", "64.29", "64.30", "64.3", "64.31", "64.32", "64.33", "64.34",
+	"64.35", "64.36", "64.37", "64.38", "64.39", "64.40", "64.4", "64.41", "64.42", "64.43", "64.44", "64.45", "64.46", "64.47", "64.48", "64.49",
+	"64.50", "64.5", "64.51", "64.52", "64.53", "64.54", "64.55", "64.56", "64.57", "64.58", "64.59", "64.60", "64.6", "64.61", "64.62", "64.63",
+	"64.64", "64.65", "64.66", "64.67", "64.68", "64.69", "64.70", "64.7", "64.71", "64.72", "64.73", "64.74", "64.75", "64.76", "64.77", "64.78",
+	"64.79", "64.80", "64.8", "64.81", "64.82", "64.83", "64.84", "64.85", "64.86", "64.87", "64.88", "64.89", "64.90", "64.9", "64.91", "64.92",
+	"64.93", "64.94", "64.95", "64.96", "64.97", "64.98", "64.99", "65.00", "65.0", "65.01", "65.02", "65.03", "65.04", "65.05", "65.06", "65.07",
+	"65.08", "65.09", "65.10", "65.1", "65.11", "65.12", "65.13", "65.14", "65.15", "65.16", "65.17", "65.18", "65.19", "65.20", "65.2", "65.21",
+	"65.22", "65.23", "65.24", "65.25", "65.26", "65.27", "65.28", "65.29", "65.30", "65.3", "65.31", "65.32", "65.33", "65.34", "65.35", "65.36",
+	"65.37", "65.38", "65.39", "65.40", "65.4", "65.41", "65.42", "65.43", "65.44", "65.45", "65.46", "65.47", "65.48", "65.49", "65.50", "65.5",
+	"65.51", "65.52", "65.53", "65.54", "65.55", "65.56", "65.57", "65.58", "65.59", "65.60", "65.6", "65.61", "65.62", "65.63", "65.64", "65.65",
+	"65.66", "65.67", "65.68", "65.69", "65.70", "65.7", "65.71", "65.72", "65.73", "65.74", "65.75", "65.76", "65.77", "65.78", "65.79", "65.80",
+	"65.8", "65.81", "65.82", "65.83", "65.84", "65.85", "65.86", "65.87", "65.88", "65.89", "65.90", "65.9", "65.91", "65.92", "65.93", "65.94",
+	"65.95", "65.96", "65.97", "65.98", "65.99", "66.00", "66.0", "66.01", "66.02", "66.03", "66.04", "66.05", "66.06", "66.07", "66.08", "66.09",
+	"66.10", "66.1", "66.11", "66.12", "66.13", "66.14", "66.15", "66.16", "66.17", "66.18", "66.19", "66.20", "66.2", "66.21", "66.22", "66.23",
+	"66.24", "66.25", "66.26", "66.27", "66.28", "66.29", "66.30", "66.3", "66.31", "66.32", "66.33", "66.34", "66.35", "66.36", "66.37", "66.38",
+	"66.39", "66.40", "66.4", "66.41", "66.42", "66.43", "66.44", "66.45", "66.46", "66.47", "66.48", "66.49", "66.50", "66.5", "66.51", "66.52",
+	"66.53", "66.54", "66.55", "66.56", "66.57", "66.58", "66.59", "66.60", "66.6", "66.61", "66.62", "66.63", "66.64", "66.65", "66.66", "66.67",
+	"66.68", "66.69", "66.70", "66.7", "66.71", "66.72", "66.73", "66.74", "66.75", "66.76", "66.77", "66.78", "66.79", "66.80", "66.8", "66.81",
+	"66.82", "66.83", "66.84", "66.85", "66.86", "66.87", "66.88", "66.89", "66.90", "66.9", "66.91", "66.92", "66.93", "66.94", "66.95", "66.96",
+	"66.97", "66.98", "66.99", "67.00", "67.0", "67.01", "67.02", "67.03", "67.04", "67.05", "67.06", "67.07", "67.08", "67.09", "67.10", "67.1",
+	"67.11", "67.12", "67.13", "67.14", "67.15", "67.16", "67.17", "67.18", "67.19", "67.20", "67.2", "67.21", "67.22", "67.23", "67.24", "67.25",
+	"67.26", "67.27", "67.28", "67.29", "67.30", "67.3", "67.31", "67.32", "67.33", "67.34", "67.35", "67.36", "67.37", "67.38", "67.39", "67.40",
+	"67.4", "67.41", "67.42", "67.43", "67.44", "67.45", "67.46", "67.47", "67.48", "67.49", "67.50", "67.5", "67.51", "67.52", "67.53", "67.54",
+	"67.55", "67.56", "67.57", "67.58", "67.59", "67.60", "67.6", "67.61", "67.62", "67.63", "67.64", "67.65", "67.66", "67.67", "67.68", "67.69",
+	"67.70", "67.7", "67.71", "67.72", "67.73", "67.74", "67.75", "67.76", "67.77", "67.78", "67.79", "67.80", "67.8", "67.81", "67.82", "67.83",
+	"67.84", "67.85", "67.86", "67.87", "67.88", "67.89", "67.90", "67.9", "67.91", "67.92", "67.93", "67.94", "67.95", "67.96", "67.97", "67.98",
+	"67.99", "68.00", "68.0", "68.01", "68.02", "68.03", "68.04", "68.05", "68.06", "68.07", "68.08", "68.09", "68.10", "68.1", "68.11", "68.12",
+	"68.13", "68.14", "68.15", "68.16", "68.17", "68.18", "68.19", "68.20", "68.2", "68.21", "68.22", "68.23", "68.24", "68.25", "68.26", "68.27",
+	"68.28", "68.29", "68.30", "68.3", "68.31", "68.32", "68.33", "68.34", "68.35", "68.36", "68.37", "68.38", "68.39", "68.40", "68.4", "68.41",
+	"68.42", "68.43", "68.44", "68.45", "68.46", "68.47", "68.48", "68.49", "68.50", "68.5", "68.51", "68.52", "68.53", "68.54", "68.55", "68.56",
+	"68.57", "68.58", "68.59", "68.60", "68.6", "68.61", "68.62", "68.63", "68.64", "68.65", "68.66", "68.67", "68.68", "68.69", "68.70", "68.7",
+	"68.71", "68.72", "68.73", "68.74", "68.75", "68.76", "68.77", "68.78", "68.79", "68.80", "68.8", "68.81", "68.82", "68.83", "68.84", "68.85",
+	"68.86", "68.87", "68.88", "68.89", "68.90", "68.9", "68.91", "68.92", "68.93", "68.94", "68.95", "68.96", "68.97", "68.98", "68.99", "69.00",
+	"69.0", "69.01", "69.02", "69.03", "69.04", "69.05", "69.06", "69.07", "69.08", "69.09", "69.10", "69.1", "69.11", "69.12", "69.13", "69.14",
+	"69.15", "69.16", "69.17", "69.18", "69.19", "69.20", "69.2", "69.21", "69.22", "69.23", "69.24", "69.25", "69.26", "69.27", "69.28", "69.29",
+	"69.30", "69.3", "69.31", "69.32", "69.33", "69.34", "69.35", "69.36", "69.37", "69.38", "69.39", "69.40", "69.4", "69.41", "69.42", "69.43",
+	"69.44", "69.45", "69.46", "69.47", "69.48", "69.49", "69.50", "69.5", "69.51", "69.52", "69.53", "69.54", "69.55", "69.56", "69.57", "69.58",
+	"69.59", "69.60", "69.6", "69.61", "69.62", "69.63", "69.64", "69.65", "69.66", "69.67", "69.68", "69.69", "69.70", "69.7", "69.71", "69.72",
+	"69.73", "69.74", "69.75", "69.76", "69.77", "69.78", "69.79", "69.80", "69.8", "69.81", "69.82", "69.83", "69.84", "69.85", "69.86", "69.87",
+	"69.88", "69.89", "69.90", "69.9", "69.91", "69.92", "69.93", "69.94", "69.95", "69.96", "69.97", "69.98", "69.99", "70.00", "70.0", "70.01",
+	"70.02", "70.03", "70.04", "70.05", "70.06", "70.07", "70.08", "70.09", "70.10", "70.1", "70.11", "70.12", "70.13", "70.14", "70.15", "70.16",
+	"70.17", "70.18", "70.19", "70.20", "70.2", "70.21", "70.22", "70.23", "70.24", "70.25", "70.26", "70.27", "70.28", "70.29", "70.30", "70.3",
+	"70.31", "70.32", "70.33", "70.34", "70.35", "70.36", "70.37", "70.38", "70.39", "70.40", "70.4", "70.41", "70.42", "70.43", "70.44", "70.45",
+	"70.46", "70.47", "70.48", "70.49", "70.50", "70.5", "70.51", "70.52", "70.53", "70.54", "70.55", "70.56", "70.57", "70.58", "70.59", "70.60",
+	"70.6", "70.61", "70.62", "70.63", "70.64", "70.65", "70.66", "70.67", "70.68", "70.69", "70.70", "70.7", "70.71", "70.72", "70.73", "70.74",
+	"70.75", "70.76", "70.77", "70.78", "70.79", "70.80", "70.8", "70.81", "70.82", "70.83", "70.84", "70.85", "70.86", "70.87", "70.88", "70.89",
+	"70.90", "70.9", "70.91", "70.92", "70.93", "70.94", "70.95", "70.96", "70.97", "70.98", "70.99", "71.00", "71.0", "71.01", "71.02", "71.03",
+	"71.04", "71.05", "71.06", "71.07", "71.08", "71.09", "71.10", "71.1", "71.11", "71.12", "71.13", "71.14", "71.15", "71.16", "71.17", "71.18",
+	"71.19", "71.20", "71.2", "71.21", "71.22", "71.23", "71.24", "71.25", "71.26", "71.27", "71.28", "71.29", "71.30", "71.3", "71.31", "71.32",
+	"71.33", "71.34", "71.35", "71.36", "71.37", "71.38", "71.39", "71.40", "71.4", "71.41", "71.42", "71.43", "71.44", "71.45", "71.46", "71.47",
+	"71.48", "71.49", "71.50", "71.5", "71.51", "71.52", "71.53", "71.54", "71.55", "71.56", "71.57", "71.58", "71.59", "71.60", "71.6", "71.61",
+	"71.62", "71.63", "71.64", "71.65", "71.66", "71.67", "71.68", "71.69", "71.70", "71.7", "71.71", "71.72", "71.73", "71.74", "71.75", "71.76",
+	"71.77", "71.78", "71.79", "71.80", "71.8", "71.81", "71.82", "71.83", "71.84", "71.85", "71.86", "71.87", "71.88", "71.89", "71.90", "71.9",
+	"71.91", "71.92", "71.93", "71.94", "71.95", "71.96", "71.97", "71.98", "71.99", "72.00", "72.0", "72.01", "72.02", "72.03", "72.04", "72.05",
+	"72.06", "72.07", "72.08", "72.09", "72.10", "72.1", "72.11", "72.12", "72.13", "72.14", "72.15", "72.16", "72.17", "72.18", "72.19", "72.20",
+	"72.2", "72.21", "72.22", "72.23", "72.24", "72.25", "72.26", "72.27", "72.28", "72.29", "72.30", "72.3", "72.31", "72.32", "72.33", "72.34",
+	"72.35", "72.36", "72.37", "72.38", "72.39", "72.40", "72.4", "72.41", "72.42", "72.43", "72.44", "72.45", "72.46", "72.47", "72.48", "72.49",
+	"72.50", "72.5", "72.51", "72.52", "72.53", "72.54", "72.55", "72.56", "72.57", "72.58", "72.59", "72.60", "72.6", "72.61", "72.62", "72.63",
+	"72.64", "72.65", "72.66", "72.67", "72.68", "72.69", "72.70", "72.7", "72.71", "72.72", "72.73", "72.74", "72.75", "72.76", "72.77", "72.78",
+	"72.79", "72.80", "72.8", "72.81", "72.82", "72.83", "72.84", "72.85", "72.86", "72.87", "72.88", "72.89", "72.90", "72.9", "72.91", "72.92",
+	"72.93", "72.94", "72.95", "72.96", "72.97", "72.98", "72.99", "73.00", "73.0", "73.01", "73.02", "73.03", "73.04", "73.05", "73.06", "73.07",
+	"73.08", "73.09", "73.10", "73.1", "73.11", "73.12", "73.13", "73.14", "73.15", "73.16", "73.17", "73.18", "73.19", "73.20", "73.2", "73.21",
+	"73.22", "73.23", "73.24", "73.25", "73.26", "73.27", "73.28", "73.29", "73.30", "73.3", "73.31", "73.32", "73.33", "73.34", "73.35", "73.36",
+	"73.37", "73.38", "73.39", "73.40", "73.4", "73.41", "73.42", "73.43", "73.44", "73.45", "73.46", "73.47", "73.48", "73.49", "73.50", "73.5",
+	"73.51", "73.52", "73.53", "73.54", "73.55", "73.56", "73.57", "73.58", "73.59", "73.60", "73.6", "73.61", "73.62", "73.63", "73.64", "73.65",
+	"73.66", "73.67", "73.68", "73.69", "73.70", "73.7", "73.71", "73.72", "73.73", "73.74", "73.75", "73.76", "73.77", "73.78", "73.79", "73.80",
+	"73.8", "73.81", "73.82", "73.83", "73.84", "73.85", "73.86", "73.87", "73.88", "73.89", "73.90", "73.9", "73.91", "73.92", "73.93", "73.94",
+	"73.95", "73.96", "73.97", "73.98", "73.99", "74.00", "74.0", "74.01", "74.02", "74.03", "74.04", "74.05", "74.06", "74.07", "74.08", "74.09",
+	"74.10", "74.1", "74.11", "74.12", "74.13", "74.14", "74.15", "74.16", "74.17", "74.18", "74.19", "74.20", "74.2", "74.21", "74.22", "74.23",
+	"74.24", "74.25", "74.26", "74.27", "74.28", "74.29", "74.30", "74.3", "74.31", "74.32", "74.33", "74.34", "74.35", "74.36", "74.37", "74.38",
+	"74.39", "74.40", "74.4", "74.41", "74.42", "74.43", "74.44", "74.45", "74.46", "74.47", "74.48", "74.49", "74.50", "74.5", "74.51", "74.52",
+	"74.53", "74.54", "74.55", "74.56", "74.57", "74.58", "74.59", "74.60", "74.6", "74.61", "74.62", "74.63", "74.64", "74.65", "74.66", "74.67",
+	"74.68", "74.69", "74.70", "74.7", "74.71", "74.72", "74.73", "74.74", "74.75", "74.76", "74.77", "74.78", "74.79", "74.80", "74.8", "74.81",
+	"74.82", "74.83", "74.84", "74.85", "74.86", "74.87", "74.88", "74.89", "74.90", "74.9", "74.91", "74.92", "74.93", "74.94", "74.95", "74.96",
+	"74.97", "74.98", "74.99", "75.00", "75.0", "75.01", "75.02", "75.03", "75.04", "75.05", "75.06", "75.07", "75.08", "75.09", "75.10", "75.1",
+	"75.11", "75.12", "75.13", "75.14", "75.15", "75.16", "75.17", "75.18", "75.19", "75.20", "75.2", "75.21", "75.22", "75.23", "75.24", "75.25",
+	"75.26", "75.27", "75.28", "75.29", "75.30", "75.3", "75.31", "75.32", "75.33", "75.34", "75.35", "75.36", "75.37", "75.38", "75.39", "75.40",
+	"75.4", "75.41", "75.42", "75.43", "75.44", "75.45", "75.46", "75.47", "75.48", "75.49", "75.50", "75.5", "75.51", "75.52", "75.53", "75.54",
+	"75.55", "75.56", "75.57", "75.58", "75.59", "75.60", "75.6", "75.61", "75.62", "75.63", "75.64", "75.65", "75.66", "75.67", "75.68", "75.69",
+	"75.70", "75.7", "75.71", "75.72", "75.73", "75.74", "75.75", "75.76", "75.77", "75.78", "75.79", "75.80", "75.8", "75.81", "75.82", "75.83",
+	"75.84", "75.85", "75.86", "75.87", "75.88", "75.89", "75.90", "75.9", "75.91", "75.92", "75.93", "75.94", "75.95", "75.96", "75.97", "75.98",
+	"75.99", "76.00", "76.0", "76.01", "76.02", "76.03", "76.04", "76.05", "76.06", "76.07", "76.08", "76.09", "76.10", "76.1", "76.11", "76.12",
+	"76.13", "76.14", "76.15", "76.16", "76.17", "76.18", "76.19", "76.20", "76.2", "76.21", "76.22", "76.23", "76.24", "76.25", "76.26", "76.27",
+	"76.28", "76.29", "76.30", "76.3", "76.31", "76.32", "76.33", "76.34", "76.35", "76.36", "76.37", "76.38", "76.39", "76.40", "76.4", "76.41",
+	"76.42", "76.43", "76.44", "76.45", "76.46", "76.47", "76.48", "76.49", "76.50", "76.5", "76.51", "76.52", "76.53", "76.54", "76.55", "76.56",
+	"76.57", "76.58", "76.59", "76.60", "76.6", "76.61", "76.62", "76.63", "76.64", "76.65", "76.66", "76.67", "76.68", "76.69", "76.70", "76.7",
+	"76.71", "76.72", "76.73", "76.74", "76.75", "76.76", "76.77", "76.78", "76.79", "76.80", "76.8", "76.81", "76.82", "76.83", "76.84", "76.85",
+	"76.86", "76.87", "76.88", "76.89", "76.90", "76.9", "76.91", "76.92", "76.93", "76.94", "76.95", "76.96", "76.97", "76.98", "76.99", "77.00",
+	"77.0", "77.01", "77.02", "77.03", "77.04", "77.05", "77.06", "77.07", "77.08", "77.09", "77.10", "77.1", "77.11", "77.12", "77.13", "77.14",
+	"77.15", "77.16", "77.17", "77.18", "77.19", "77.20", "77.2", "77.21", "77.22", "77.23", "77.24", "77.25", "77.26", "77.27", "77.28", "77.29",
+	"77.30", "77.3", "77.31", "77.32", "77.33", "77.34", "77.35", "77.36", "77.37", "77.38", "77.39", "77.40", "77.4", "77.41", "77.42", "77.43",
+	"77.44", "77.45", "77.46", "77.47", "77.48", "77.49", "77.50", "77.5", "77.51", "77.52", "77.53", "77.54", "77.55", "77.56", "77.57", "77.58",
+	"77.59", "77.60", "77.6", "77.61", "77.62", "77.63", "77.64", "77.65", "77.66", "77.67", "77.68", "77.69", "77.70", "77.7", "77.71", "77.72",
+	"77.73", "77.74", "77.75", "77.76", "77.77", "77.78", "77.79", "77.80", "77.8", "77.81", "77.82", "77.83", "77.84", "77.85", "77.86", "77.87",
+	"77.88", "77.89", "77.90", "77.9", "77.91", "77.92", "77.93", "77.94", "77.95", "77.96", "77.97", "77.98", "77.99", "78.00", "78.0", "78.01",
+	"78.02", "78.03", "78.04", "78.05", "78.06", "78.07", "78.08", "78.09", "78.10", "78.1", "78.11", "78.12", "78.13", "78.14", "78.15", "78.16",
+	"78.17", "78.18", "78.19", "78.20", "78.2", "78.21", "78.22", "78.23", "78.24", "78.25", "78.26", "78.27", "78.28", "78.29", "78.30", "78.3",
+	"78.31", "78.32", "78.33", "78.34", "78.35", "78.36", "78.37", "78.38", "78.39", "78.40", "78.4", "78.41", "78.42", "78.43", "78.44", "78.45",
+	"78.46", "78.47", "78.48", "78.49", "78.50", "78.5", "78.51", "78.52", "78.53", "78.54", "78.55", "78.56", "78.57", "78.58", "78.59", "78.60",
+	"78.6", "78.61", "78.62", "78.63", "78.64", "78.65", "78.66", "78.67", "78.68", "78.69", "78.70", "78.7", "78.71", "78.72", "78.73", "78.74",
+	"78.75", "78.76", "78.77", "78.78", "78.79", "78.80", "78.8", "78.81", "78.82", "78.83", "78.84", "78.85", "78.86", "78.87", "78.88", "78.89",
+	"78.90", "78.9", "78.91", "78.92", "78.93", "78.94", "78.95", "78.96", "78.97", "78.98", "78.99", "79.00", "79.0", "79.01", "79.02", "79.03",
+	"79.04", "79.05", "79.06", "79.07", "79.08", "79.09", "79.10", "79.1", "79.11", "79.12", "79.13", "79.14", "79.15", "79.16", "79.17", "79.18",
+	"79.19", "79.20", "79.2", "79.21", "79.22", "79.23", "79.24", "79.25", "79.26", "79.27", "79.28", "79.29", "79.30", "79.3", "79.31", "79.32",
+	"79.33", "79.34", "79.35", "79.36", "79.37", "79.38", "79.39", "79.40", "79.4", "79.41", "79.42", "79.43", "79.44", "79.45", "79.46", "79.47",
+	"79.48", "79.49", "79.50", "79.5", "79.51", "79.52", "79.53", "79.54", "79.55", "79.56", "79.57", "79.58", "79.59", "79.60", "79.6", "79.61",
+	"79.62", "79.63", "79.64", "79.65", "79.66", "79.67", "79.68", "79.69", "79.70", "79.7", "79.71", "79.72", "79.73", "79.74", "79.75", "79.76",
+	"79.77", "79.78", "79.79", "79.80", "79.8", "79.81", "79.82", "79.83", "79.84", "79.85", "79.86", "79.87", "79.88", "79.89", "79.90", "79.9",
+	"79.91", "79.92", "79.93", "79.94", "79.95", "79.96", "79.97", "79.98", "79.99", "80.00", "80.0", "80.01", "80.02", "80.03", "80.04", "80.05",
+	"80.06", "80.07", "80.08", "80.09", "80.10", "80.1", "80.11", "80.12", "80.13", "80.14", "80.15", "80.16", "80.17", "80.18", "80.19", "80.20",
+	"80.2", "80.21", "80.22", "80.23", "80.24", "80.25", "80.26", "80.27", "80.28", "80.29", "80.30", "80.3", "80.31", "80.32", "80.33", "80.34",
+	"80.35", "80.36", "80.37", "80.38", "80.39", "80.40", "80.4", "80.41", "80.42", "80.43", "80.44", "80.45", "80.46", "80.47", "80.48", "80.49",
+	"80.50", "80.5", "80.51", "80.52", "80.53", "80.54", "80.55", "80.56", "80.57", "80.58", "80.59", "80.60", "80.6", "80.61", "80.62", "80.63",
+	"80.64", "80.65", "80.66", "80.67", "80.68", "80.69", "80.70", "80.7", "80.71", "80.72", "80.73", "80.74", "80.75", "80.76", "80.77", "80.78",
+	"80.79", "80.80", "80.8", "80.81", "80.82", "80.83", "80.84", "80.85", "80.86", "80.87", "80.88", "80.89", "80.90", "80.9", "80.91", "80.92",
+	"80.93", "80.94", "80.95", "80.96", "80.97", "80.98", "80.99", "81.00", "81.0", "81.01", "81.02", "81.03", "81.04", "81.05", "81.06", "81.07",
+	"81.08", "81.09", "81.10", "81.1", "81.11", "81.12", "81.13", "81.14", "81.15", "81.16", "81.17", "81.18", "81.19", "81.20", "81.2", "81.21",
+	"81.22", "81.23", "81.24", "81.25", "81.26", "81.27", "81.28", "81.29", "81.30", "81.3", "81.31", "81.32", "81.33", "81.34", "81.35", "81.36",
+	"81.37", "81.38", "81.39", "81.40", "81.4", "81.41", "81.42", "81.43", "81.44", "81.45", "81.46", "81.47", "81.48", "81.49", "81.50", "81.5",
+	"81.51", "81.52", "81.53", "81.54", "81.55", "81.56", "81.57", "81.58", "81.59", "81.60", "81.6", "81.61", "81.62", "81.63", "81.64", "81.65",
+	"81.66", "81.67", "81.68", "81.69", "81.70", "81.7", "81.71", "81.72", "81.73", "81.74", "81.75", "81.76", "81.77", "81.78", "81.79", "81.80",
+	"81.8", "81.81", "81.82", "81.83", "81.84", "81.85", "81.86", "81.87", "81.88", "81.89", "81.90", "81.9", "81.91", "81.92", "81.93", "81.94",
+	"81.95", "81.96", "81.97", "81.98", "81.99", "82.00", "82.0", "82.01", "82.02", "82.03", "82.04", "82.05", "82.06", "82.07", "82.08", "82.09",
+	"82.10", "82.1", "82.11", "82.12", "82.13", "82.14", "82.15", "82.16", "82.17", "82.18", "82.19", "82.20", "82.2", "82.21", "82.22", "82.23",
+	"82.24", "82.25", "82.26", "82.27", "82.28", "82.29", "82.30", "82.3", "82.31", "82.32", "82.33", "82.34", "82.35", "82.36", "82.37", "82.38",
+	"82.39", "82.40", "82.4", "82.41", "82.42", "82.43", "82.44", "82.45", "82.46", "82.47", "82.48", "82.49", "82.50", "82.5", "82.51", "82.52",
+	"82.53", "82.54", "82.55", "82.56", "82.57", "82.58", "82.59", "82.60", "82.6", "82.61", "82.62", "82.63", "82.64", "82.65", "82.66", "82.67",
+	"82.68", "82.69", "82.70", "82.7", "82.71", "82.72", "82.73", "82.74", "82.75", "82.76", "82.77", "82.78", "82.79", "82.80", "82.8", "82.81",
+	"82.82", "82.83", "82.84", "82.85", "82.86", "82.87", "82.88", "82.89", "82.90", "82.9", "82.91", "82.92", "82.93", "82.94", "82.95", "82.96",
+	"82.97", "82.98", "82.99", "83.00", "83.0", "83.01", "83.02", "83.03", "83.04", "83.05", "83.06", "83.07", "83.08", "83.09", "83.10", "83.1",
+	"83.11", "83.12", "83.13", "83.14", "83.15", "83.16", "83.17", "83.18", "83.19", "83.20", "83.2", "83.21", "83.22", "83.23", "83.24", "83.25",
+	"83.26", "83.27", "83.28", "83.29", "83.30", "83.3", "83.31", "83.32", "83.33", "83.34", "83.35", "83.36", "83.37", "83.38", "83.39", "83.40",
+	"83.4", "83.41", "83.42", "83.43", "83.44", "83.45", "83.46", "83.47", "83.48", "83.49", "83.50", "83.5", "83.51", "83.52", "83.53", "83.54",
+	"83.55", "83.56", "83.57", "83.58", "83.59", "83.60", "83.6", "83.61", "83.62", "83.63", "83.64", "83.65", "83.66", "83.67", "83.68", "83.69",
+	"83.70", "83.7", "83.71", "83.72", "83.73", "83.74", "83.75", "83.76", "83.77", "83.78", "83.79", "83.80", "83.8", "83.81", "83.82", "83.83",
+	"83.84", "83.85", "83.86", "83.87", "83.88", "83.89", "83.90", "83.9", "83.91", "83.92", "83.93", "83.94", "83.95", "83.96", "83.97", "83.98",
+	"83.99", "84.00", "84.0", "84.01", "84.02", "84.03", "84.04", "84.05", "84.06", "84.07", "84.08", "84.09", "84.10", "84.1", "84.11", "84.12",
+	"84.13", "84.14", "84.15", "84.16", "84.17", "84.18", "84.19", "84.20", "84.2", "84.21", "84.22", "84.23", "84.24", "84.25", "84.26", "84.27",
+	"84.28", "84.29", "84.30", "84.3", "84.31", "84.32", "84.33", "84.34", "84.35", "84.36", "84.37", "84.38", "84.39", "84.40", "84.4", "84.41",
+	"84.42", "84.43", "84.44", "84.45", "84.46", "84.47", "84.48", "84.49", "84.50", "84.5", "84.51", "84.52", "84.53", "84.54", "84.55", "84.56",
+	"84.57", "84.58", "84.59", "84.60", "84.6", "84.61", "84.62", "84.63", "84.64", "84.65", "84.66", "84.67", "84.68", "84.69", "84.70", "84.7",
+	"84.71", "84.72", "84.73", "84.74", "84.75", "84.76", "84.77", "84.78", "84.79", "84.80", "84.8", "84.81", "84.82", "84.83", "84.84", "84.85",
+	"84.86", "84.87", "84.88", "84.89", "84.90", "84.9", "84.91", "84.92", "84.93", "84.94", "84.95", "84.96", "84.97", "84.98", "84.99", "85.00",
+	"85.0", "85.01", "85.02", "85.03", "85.04", "85.05", "85.06", "85.07", "85.08", "85.09", "85.10", "85.1", "85.11", "85.12", "85.13", "85.14",
+	"85.15", "85.16", "85.17", "85.18", "85.19", "85.20", "85.2", "85.21", "85.22", "85.23", "85.24", "85.25", "85.26", "85.27", "85.28", "85.29",
+	"85.30", "85.3", "85.31", "85.32", "85.33", "85.34", "85.35", "85.36", "85.37", "85.38", "85.39", "85.40", "85.4", "85.41", "85.42", "85.43",
+	"85.44", "85.45", "85.46", "85.47", "85.48", "85.49", "85.50", "85.5", "85.51", "85.52", "85.53", "85.54", "85.55", "85.56", "85.57", "85.58",
+	"85.59", "85.60", "85.6", "85.61", "85.62", "85.63", "85.64", "85.65", "85.66", "85.67", "85.68", "85.69", "85.70", "85.7", "85.71", "85.72",
+	"85.73", "85.74", "85.75", "85.76", "85.77", "85.78", "85.79", "85.80", "85.8", "85.81", "85.82", "85.83", "85.84", "85.85", "85.86", "85.87",
+	"85.88", "85.89", "85.90", "85.9", "85.91", "85.92", "85.93", "85.94", "85.95", "85.96", "85.97", "85.98", "85.99", "86.00", "86.0", "86.01",
+	"86.02", "86.03", "86.04", "86.05", "86.06", "86.07", "86.08", "86.09", "86.10", "86.1", "86.11", "86.12", "86.13", "86.14", "86.15", "86.16",
+	"86.17", "86.18", "86.19", "86.20", "86.2", "86.21", "86.22", "86.23", "86.24", "86.25", "86.26", "86.27", "86.28", "86.29", "86.30", "86.3",
+	"86.31", "86.32", "86.33", "86.34", "86.35", "86.36", "86.37", "86.38", "86.39", "86.40", "86.4", "86.41", "86.42", "86.43", "86.44", "86.45",
+	"86.46", "86.47", "86.48", "86.49", "86.50", "86.5", "86.51", "86.52", "86.53", "86.54", "86.55", "86.56", "86.57", "86.58", "86.59", "86.60",
+	"86.6", "86.61", "86.62", "86.63", "86.64", "86.65", "86.66", "86.67", "86.68", "86.69", "86.70", "86.7", "86.71", "86.72", "86.73", "86.74",
+	"86.75", "86.76", "86.77", "86.78", "86.79", "86.80", "86.8", "86.81", "86.82", "86.83", "86.84", "86.85", "86.86", "86.87", "86.88", "86.89",
+	"86.90", "86.9", "86.91", "86.92", "86.93", "86.94", "86.95", "86.96", "86.97", "86.98", "86.99", "87.00", "87.0", "87.01", "87.02", "87.03",
+	"87.04", "87.05", "87.06", "87.07", "87.08", "87.09", "87.10", "87.1", "87.11", "87.12", "87.13", "87.14", "87.15", "87.16", "87.17", "87.18",
+	"87.19", "87.20", "87.2", "87.21", "87.22", "87.23", "87.24", "87.25", "87.26", "87.27", "87.28", "87.29", "87.30", "87.3", "87.31", "87.32",
+	"87.33", "87.34", "87.35", "87.36", "87.37", "87.38", "87.39", "87.40", "87.4", "87.41", "87.42", "87.43", "87.44", "87.45", "87.46", "87.47",
+	"87.48", "87.49", "87.50", "87.5", "87.51", "87.52", "87.53", "87.54", "87.55", "87.56", "87.57", "87.58", "87.59", "87.60", "87.6", "87.61",
+	"87.62", "87.63", "87.64", "87.65", "87.66", "87.67", "87.68", "87.69", "87.70", "87.7", "87.71", "87.72", "87.73", "87.74", "87.75", "87.76",
+	"87.77", "87.78", "87.79", "87.80", "87.8", "87.81", "87.82", "87.83", "87.84", "87.85", "87.86", "87.87", "87.88", "87.89", "87.90", "87.9",
+	"87.91", "87.92", "87.93", "87.94", "87.95", "87.96", "87.97", "87.98", "87.99", "88.00", "88.0", "88.01", "88.02", "88.03", "88.04", "88.05",
+	"88.06", "88.07", "88.08", "88.09", "88.10", "88.1", "88.11", "88.12", "88.13", "88.14", "88.15", "88.16", "88.17", "88.18", "88.19", "88.20",
+	"88.2", "88.21", "88.22", "88.23", "88.24", "88.25", "88.26", "88.27", "88.28", "88.29", "88.30", "88.3", "88.31", "88.32", "88.33", "88.34",
+	"88.35", "88.36", "88.37", "88.38", "88.39", "88.40", "88.4", "88.41", "88.42", "88.43", "88.44", "88.45", "88.46", "88.47", "88.48", "88.49",
+	"88.50", "88.5", "88.51", "88.52", "88.53", "88.54", "88.55", "88.56", "88.57", "88.58", "88.59", "88.60", "88.6", "88.61", "88.62", "88.63",
+	"88.64", "88.65", "88.66", "88.67", "88.68", "88.69", "88.70", "88.7", "88.71", "88.72", "88.73", "88.74", "88.75", "88.76", "88.77", "88.78",
+	"88.79", "88.80", "88.8", "88.81", "88.82", "88.83", "88.84", "88.85", "88.86", "88.87", "88.88", "88.89", "88.90", "88.9", "88.91", "88.92",
+	"88.93", "88.94", "88.95", "88.96", "88.97", "88.98", "88.99", "89.00", "89.0", "89.01", "89.02", "89.03", "89.04", "89.05", "89.06", "89.07",
+	"89.08", "89.09", "89.10", "89.1", "89.11", "89.12", "89.13", "89.14", "89.15", "89.16", "89.17", "89.18", "89.19", "89.20", "89.2", "89.21",
+	"89.22", "89.23", "89.24", "89.25", "89.26", "89.27", "89.28", "89.29", "89.30", "89.3", "89.31", "89.32", "89.33", "89.34", "89.35", "89.36",
+	"89.37", "89.38", "89.39", "89.40", "89.4", "89.41", "89.42", "89.43", "89.44", "89.45", "89.46", "89.47", "89.48", "89.49", "89.50", "89.5",
+	"89.51", "89.52", "89.53", "89.54", "89.55", "89.56", "89.57", "89.58", "89.59", "89.60", "89.6", "89.61", "89.62", "89.63", "89.64", "89.65",
+	"89.66", "89.67", "89.68", "89.69", "89.70", "89.7", "89.71", "89.72", "89.73", "89.74", "89.75", "89.76", "89.77", "89.78", "89.79", "89.80",
+	"89.8", "89.81", "89.82", "89.83", "89.84", "89.85", "89.86", "89.87", "89.88", "89.89", "89.90", "89.9", "89.91", "89.92", "89.93", "89.94",
+	"89.95", "89.96", "89.97", "89.98", "89.99", "90.00", "90.0", "90.01", "90.02", "90.03", "90.04", "90.05", "90.06", "90.07", "90.08", "90.09",
+	"90.10", "90.1", "90.11", "90.12", "90.13", "90.14", "90.15", "90.16", "90.17", "90.18", "90.19", "90.20", "90.2", "90.21", "90.22", "90.23",
+	"90.24", "90.25", "90.26", "90.27", "90.28", "90.29", "90.30", "90.3", "90.31", "90.32", "90.33", "90.34", "90.35", "90.36", "90.37", "90.38",
+	"90.39", "90.40", "90.4", "90.41", "90.42", "90.43", "90.44", "90.45", "90.46", "90.47", "90.48", "90.49", "90.50", "90.5", "90.51", "90.52",
+	"90.53", "90.54", "90.55", "90.56", "90.57", "90.58", "90.59", "90.60", "90.6", "90.61", "90.62", "90.63", "90.64", "90.65", "90.66", "90.67",
+	"90.68", "90.69", "90.70", "90.7", "90.71", "90.72", "90.73", "90.74", "90.75", "90.76", "90.77", "90.78", "90.79", "90.80", "90.8", "90.81",
+	"90.82", "90.83", "90.84", "90.85", "90.86", "90.87", "90.88", "90.89", "90.90", "90.9", "90.91", "90.92", "90.93", "90.94", "90.95", "90.96",
+	"90.97", "90.98", "90.99", "91.00", "91.0", "91.01", "91.02", "91.03", "91.04", "91.05", "91.06", "91.07", "91.08", "91.09", "91.10", "91.1",
+	"91.11", "91.12", "91.13", "91.14", "91.15", "91.16", "91.17", "91.18", "91.19", "91.20", "91.2", "91.21", "91.22", "91.23", "91.24", "91.25",
+	"91.26", "91.27", "91.28", "91.29", "91.30", "91.3", "91.31", "91.32", "91.33", "91.34", "91.35", "91.36", "91.37", "91.38", "91.39", "91.40",
+	"91.4", "91.41", "91.42", "91.43", "91.44", "91.45", "91.46", "91.47", "91.48", "91.49", "91.50", "91.5", "91.51", "91.52", "91.53", "91.54",
+	"91.55", "91.56", "91.57", "91.58", "91.59", "91.60", "91.6", "91.61", "91.62", "91.63", "91.64", "91.65", "91.66", "91.67", "91.68", "91.69",
+	"91.70", "91.7", "91.71", "91.72", "91.73", "91.74", "91.75", "91.76", "91.77", "91.78", "91.79", "91.80", "91.8", "91.81", "91.82", "91.83",
+	"91.84", "91.85", "91.86", "91.87", "91.88", "91.89", "91.90", "91.9", "91.91", "91.92", "91.93", "91.94", "91.95", "91.96", "91.97", "91.98",
+	"91.99", "92.00", "92.0", "92.01", "92.02", "92.03", "92.04", "92.05", "92.06", "92.07", "92.08", "92.09", "92.10", "92.1", "92.11", "92.12",
+	"92.13", "92.14", "92.15", "92.16", "92.17", "92.18", "92.19", "92.20", "92.2", "92.21", "92.22", "92.23", "92.24", "92.25", "92.26", "92.27",
+	"92.28", "92.29", "92.30", "92.3", "92.31", "92.32", "92.33", "92.34", "92.35", "92.36", "92.37", "92.38", "92.39", "92.40", "92.4", "92.41",
+	"92.42", "92.43", "92.44", "92.45", "92.46", "92.47", "92.48", "92.49", "92.50", "92.5", "92.51", "92.52", "92.53", "92.54", "92.55", "92.56",
+	"92.57", "92.58", "92.59", "92.60", "92.6", "92.61", "92.62", "92.63", "92.64", "92.65", "92.66", "92.67", "92.68", "92.69", "92.70", "92.7",
+	"92.71", "92.72", "92.73", "92.74", "92.75", "92.76", "92.77", "92.78", "92.79", "92.80", "92.8", "92.81", "92.82", "92.83", "92.84", "92.85",
+	"92.86", "92.87", "92.88", "92.89", "92.90", "92.9", "92.91", "92.92", "92.93", "92.94", "92.95", "92.96", "92.97", "92.98", "92.99", "93.00",
+	"93.0", "93.01", "93.02", "93.03", "93.04", "93.05", "93.06", "93.07", "93.08", "93.09", "93.10", "93.1", "93.11", "93.12", "93.13", "93.14",
+	"93.15", "93.16", "93.17", "93.18", "93.19", "93.20", "93.2", "93.21", "93.22", "93.23", "93.24", "93.25", "93.26", "93.27", "93.28", "93.29",
+	"93.30", "93.3", "93.31", "93.32", "93.33", "93.34", "93.35", "93.36", "93.37", "93.38", "93.39", "93.40", "93.4", "93.41", "93.42", "93.43",
+	"93.44", "93.45", "93.46", "93.47", "93.48", "93.49", "93.50", "93.5", "93.51", "93.52", "93.53", "93.54", "93.55", "93.56", "93.57", "93.58",
+	"93.59", "93.60", "93.6", "93.61", "93.62", "93.63", "93.64", "93.65", "93.66", "93.67", "93.68", "93.69", "93.70", "93.7", "93.71", "93.72",
+	"93.73", "93.74", "93.75", "93.76", "93.77", "93.78", "93.79", "93.80", "93.8", "93.81", "93.82", "93.83", "93.84", "93.85", "93.86", "93.87",
+	"93.88", "93.89", "93.90", "93.9", "93.91", "93.92", "93.93", "93.94", "93.95", "93.96", "93.97", "93.98", "93.99", "94.00", "94.0", "94.01",
+	"94.02", "94.03", "94.04", "94.05", "94.06", "94.07", "94.08", "94.09", "94.10", "94.1", "94.11", "94.12", "94.13", "94.14", "94.15", "94.16",
+	"94.17", "94.18", "94.19", "94.20", "94.2", "94.21", "94.22", "94.23", "94.24", "94.25", "94.26", "94.27", "94.28", "94.29", "94.30", "94.3",
+	"94.31", "94.32", "94.33", "94.34", "94.35", "94.36", "94.37", "94.38", "94.39", "94.40", "94.4", "94.41", "94.42", "94.43", "94.44", "94.45",
+	"94.46", "94.47", "94.48", "94.49", "94.50", "94.5", "94.51", "94.52", "94.53", "94.54", "94.55", "94.56", "94.57", "94.58", "94.59", "94.60",
+	"94.6", "94.61", "94.62", "94.63", "94.64", "94.65", "94.66", "94.67", "94.68", "94.69", "94.70", "94.7", "94.71", "94.72", "94.73", "94.74",
+	"94.75", "94.76", "94.77", "94.78", "94.79", "94.80", "94.8", "94.81", "94.82", "94.83", "94.84", "94.85", "94.86", "94.87", "94.88", "94.89",
+	"94.90", "94.9", "94.91", "94.92", "94.93", "94.94", "94.95", "94.96", "94.97", "94.98", "94.99", "95.00", "95.0", "95.01", "95.02", "95.03",
+	"95.04", "95.05", "95.06", "95.07", "95.08", "95.09", "95.10", "95.1", "95.11", "95.12", "95.13", "95.14", "95.15", "95.16", "95.17", "95.18",
+	"95.19", "95.20", "95.2", "95.21", "95.22", "95.23", "95.24", "95.25", "95.26", "95.27", "95.28", "95.29", "95.30", "95.3", "95.31", "95.32",
+	"95.33", "95.34", "95.35", "95.36", "95.37", "95.38", "95.39", "95.40", "95.4", "95.41", "95.42", "95.43", "95.44", "95.45", "95.46", "95.47",
+	"95.48", "95.49", "95.50", "95.5", "95.51", "95.52", "95.53", "95.54", "95.55", "95.56", "95.57", "95.58", "95.59", "95.60", "95.6", "95.61",
+	"95.62", "95.63", "95.64", "95.65", "95.66", "95.67", "95.68", "95.69", "95.70", "95.7", "95.71", "95.72", "95.73", "95.74", "95.75", "95.76",
+	"95.77", "95.78", "95.79", "95.80", "95.8", "95.81", "95.82", "95.83", "95.84", "95.85", "95.86", "95.87", "95.88", "95.89", "95.90", "95.9",
+	"95.91", "95.92", "95.93", "95.94", "95.95", "95.96", "95.97", "95.98", "95.99", "96.00", "96.0", "96.01", "96.02", "96.03", "96.04", "96.05",
+	"96.06", "96.07", "96.08", "96.09", "96.10", "96.1", "96.11", "96.12", "96.13", "96.14", "96.15", "96.16", "96.17", "96.18", "96.19", "96.20",
+	"96.2", "96.21", "96.22", "96.23", "96.24", "96.25", "96.26", "96.27", "96.28", "96.29", "96.30", "96.3", "96.31", "96.32", "96.33", "96.34",
+	"96.35", "96.36", "96.37", "96.38", "96.39", "96.40", "96.4", "96.41", "96.42", "96.43", "96.44", "96.45", "96.46", "96.47", "96.48", "96.49",
+	"96.50", "96.5", "96.51", "96.52", "96.53", "96.54", "96.55", "96.56", "96.57", "96.58", "96.59", "96.60", "96.6", "96.61", "96.62", "96.63",
+	"96.64", "96.65", "96.66", "96.67", "96.68", "96.69", "96.70", "96.7", "96.71", "96.72", "96.73", "96.74", "96.75", "96.76", "96.77", "96.78",
+	"96.79", "96.80", "96.8", "96.81", "96.82", "96.83", "96.84", "96.85", "96.86", "96.87", "96.88", "96.89", "96.90", "96.9", "96.91", "96.92",
+	"96.93", "96.94", "96.95", "96.96", "96.97", "96.98", "96.99", "97.00", "97.0", "97.01", "97.02", "97.03", "97.04", "97.05", "97.06", "97.07",
+	"97.08", "97.09", "97.10", "97.1", "97.11", "97.12", "97.13", "97.14", "97.15", "97.16", "97.17", "97.18", "97.19", "97.20", "97.2", "97.21",
+	"97.22", "97.23", "97.24", "97.25", "97.26", "97.27", "97.28", "97.29", "97.30", "97.3", "97.31", "97.32", "97.33", "97.34", "97.35", "97.36",
+	"97.37", "97.38", "97.39", "97.40", "97.4", "97.41", "97.42", "97.43", "97.44", "97.45", "97.46", "97.47", "97.48", "97.49", "97.50", "97.5",
+	"97.51", "97.52", "97.53", "97.54", "97.55", "97.56", "97.57", "97.58", "97.59", "97.60", "97.6", "97.61", "97.62", "97.63", "97.64", "97.65",
+	"97.66", "97.67", "97.68", "97.69", "97.70", "97.7", "97.71", "97.72", "97.73", "97.74", "97.75", "97.76", "97.77", "97.78", "97.79", "97.80",
+	"97.8", "97.81", "97.82", "97.83", "97.84", "97.85", "97.86", "97.87", "97.88", "97.89", "97.90", "97.9", "97.91", "97.92", "97.93", "97.94",
+	"97.95", "97.96", "97.97", "97.98", "97.99", "98.00", "98.0", "98.01", "98.02", "98.03", "98.04", "98.05", "98.06", "98.07", "98.08", "98.09",
+	"98.10", "98.1", "98.11", "98.12", "98.13", "98.14", "98.15", "98.16", "98.17", "98.18", "98.19", "98.20", "98.2", "98.21", "98.22", "98.23",
+	"98.24", "98.25", "98.26", "98.27", "98.28", "98.29", "98.30", "98.3", "98.31", "98.32", "98.33", "98.34", "98.35", "98.36", "98.37", "98.38",
+	"98.39", "98.40", "98.4", "98.41", "98.42", "98.43", "98.44", "98.45", "98.46", "98.47", "98.48", "98.49", "98.50", "98.5", "98.51", "98.52",
+	"98.53", "98.54", "98.55", "98.56", "98.57", "98.58", "98.59", "98.60", "98.6", "98.61", "98.62", "98.63", "98.64", "98.65", "98.66", "98.67",
+	"98.68", "98.69", "98.70", "98.7", "98.71", "98.72", "98.73", "98.74", "98.75", "98.76", "98.77", "98.78", "98.79", "98.80", "98.8", "98.81",
+	"98.82", "98.83", "98.84", "98.85", "98.86", "98.87", "98.88", "98.89", "98.90", "98.9", "98.91", "98.92", "98.93", "98.94", "98.95", "98.96",
+	"98.97", "98.98", "98.99", "99.00", "99.0", "99.01", "99.02", "99.03", "99.04", "99.05", "99.06", "99.07", "99.08", "99.09", "99.10", "99.1",
+	"99.11", "99.12", "99.13", "99.14", "99.15", "99.16", "99.17", "99.18", "99.19", "99.20", "99.2", "99.21", "99.22", "99.23", "99.24", "99.25",
+	"99.26", "99.27", "99.28", "99.29", "99.30", "99.3", "99.31", "99.32", "99.33", "99.34", "99.35", "99.36", "99.37", "99.38", "99.39", "99.40",
+	"99.4", "99.41", "99.42", "99.43", "99.44", "99.45", "99.46", "99.47", "99.48", "99.49", "99.50", "99.5", "99.51", "99.52", "99.53", "99.54",
+	"99.55", "99.56", "99.57", "99.58", "99.59", "99.60", "99.6", "99.61", "99.62", "99.63", "99.64", "99.65", "99.66", "99.67", "99.68", "99.69",
+	"99.70", "99.7", "99.71", "99.72", "99.73", "99.74", "99.75", "99.76", "99.77", "99.78", "99.79", "99.80", "99.8", "99.81", "99.82", "99.83",
+	"99.84", "99.85", "99.86", "99.87", "99.88", "99.89", "99.90", "99.9", "99.91", "99.92", "99.93", "99.94", "99.95", "99.96", "99.97", "99.98",
+	"99.99", "100.00", "100.0", "100.01", "100.02", "100.03", "100.04", "100.05", "100.06", "100.07", "100.08", "100.09", "100.10", "100.1", "100.11", "100.12",
+	"100.13", "100.14", "100.15", "100.16", "100.17", "100.18", "100.19", "100.20", "100.2", "100.21", "100.22", "100.23", "100.24", "100.25", "100.26", "100.27",
+	"100.28", "100.29", "100.30", "100.3", "100.31", "100.32", "100.33", "100.34", "100.35", "100.36", "100.37", "100.38", "100.39", "100.40", "100.4", "100.41",
+	"100.42", "100.43", "100.44", "100.45", "100.46", "100.47", "100.48", "100.49", "100.50", "100.5", "100.51", "100.52", "100.53", "100.54", "100.55", "100.56",
+	"100.57", "100.58", "100.59", "100.60", "100.6", "100.61", "100.62", "100.63", "100.64", "100.65", "100.66", "100.67", "100.68", "100.69", "100.70", "100.7",
+	"100.71", "100.72", "100.73", "100.74", "100.75", "100.76", "100.77", "100.78", "100.79", "100.80", "100.8", "100.81", "100.82", "100.83", "100.84", "100.85",
+	"100.86", "100.87", "100.88", "100.89", "100.90", "100.9", "100.91", "100.92", "100.93", "100.94", "100.95", "100.96", "100.97", "100.98", "100.99", "101.00",
+	"101.0", "101.01", "101.02", "101.03", "101.04", "101.05", "101.06", "101.07", "101.08", "101.09", "101.10", "101.1", "101.11", "101.12", "101.13", "101.14",
+	"101.15", "101.16", "101.17", "101.18", "101.19", "101.20", "101.2", "101.21", "101.22", "101.23", "101.24", "101.25", "101.26", "101.27", "101.28", "101.29",
+	"101.30", "101.3", "101.31", "101.32", "101.33", "101.34", "101.35", "101.36", "101.37", "101.38", "101.39", "101.40", "101.4", "101.41", "101.42", "101.43",
+	"101.44", "101.45", "101.46", "101.47", "101.48", "101.49", "101.50", "101.5", "101.51", "101.52", "101.53", "101.54", "101.55", "101.56", "101.57", "101.58",
+	"101.59", "101.60", "101.6", "101.61", "101.62", "101.63", "101.64", "101.65", "101.66", "101.67", "101.68", "101.69", "101.70", "101.7", "101.71", "101.72",
+	"101.73", "101.74", "101.75", "101.76", "101.77", "101.78", "101.79", "101.80", "101.8", "101.81", "101.82", "101.83", "101.84", "101.85", "101.86", "101.87",
+	"101.88", "101.89", "101.90", "101.9", "101.91", "101.92", "101.93", "101.94", "101.95", "101.96", "101.97", "101.98", "101.99", "102.00", "102.0", "102.01",
+	"102.02", "102.03", "102.04", "102.05", "102.06", "102.07", "102.08", "102.09", "102.10", "102.1", "102.11", "102.12", "102.13", "102.14", "102.15", "102.16",
+	"102.17", "102.18", "102.19", "102.20", "102.2", "102.21", "102.22", "102.23", "102.24", "102.25", "102.26", "102.27", "102.28", "102.29", "102.30", "102.3",
+	"102.31", "102.32", "102.33", "102.34", "102.35", "102.36", "102.37", "102.38", "102.39", "102.40", "102.4", "102.41", "102.42", "102.43", "102.44", "102.45",
+	"102.46", "102.47", "102.48", "102.49", "102.50", "102.5", "102.51", "102.52", "102.53", "102.54", "102.55", "102.56", "102.57", "102.58", "102.59", "102.60",
+	"102.6", "102.61", "102.62", "102.63", "102.64", "102.65", "102.66", "102.67", "102.68", "102.69", "102.70", "102.7", "102.71", "102.72", "102.73", "102.74",
+	"102.75", "102.76", "102.77", "102.78", "102.79", "102.80", "102.8", "102.81", "102.82", "102.83", "102.84", "102.85", "102.86", "102.87", "102.88", "102.89",
+	"102.90", "102.9", "102.91", "102.92", "102.93", "102.94", "102.95", "102.96", "102.97", "102.98", "102.99", "103.00", "103.0", "103.01", "103.02", "103.03",
+	"103.04", "103.05", "103.06", "103.07", "103.08", "103.09", "103.10", "103.1", "103.11", "103.12", "103.13", "103.14", "103.15", "103.16", "103.17", "103.18",
+	"103.19", "103.20", "103.2", "103.21", "103.22", "103.23", "103.24", "103.25", "103.26", "103.27", "103.28", "103.29", "103.30", "103.3", "103.31", "103.32",
+	"103.33", "103.34", "103.35", "103.36", "103.37", "103.38", "103.39", "103.40", "103.4", "103.41", "103.42", "103.43", "103.44", "103.45", "103.46", "103.47",
+	"103.48", "103.49", "103.50", "103.5", "103.51", "103.52", "103.53", "103.54", "103.55", "103.56", "103.57", "103.58", "103.59", "103.60", "103.6", "103.61",
+	"103.62", "103.63", "103.64", "103.65", "103.66", "103.67", "103.68", "103.69", "103.70", "103.7", "103.71", "103.72", "103.73", "103.74", "103.75", "103.76",
+	"103.77", "103.78", "103.79", "103.80", "103.8", "103.81", "103.82", "103.83", "103.84", "103.85", "103.86", "103.87", "103.88", "103.89", "103.90", "103.9",
+	"103.91", "103.92", "103.93", "103.94", "103.95", "103.96", "103.97", "103.98", "103.99", "104.00", "104.0", "104.01", "104.02", "104.03", "104.04", "104.05",
+	"104.06", "104.07", "104.08", "104.09", "104.10", "104.1", "104.11", "104.12", "104.13", "104.14", "104.15", "104.16", "104.17", "104.18", "104.19", "104.20",
+	"104.2", "104.21", "104.22", "104.23", "104.24", "104.25", "104.26", "104.27", "104.28", "104.29", "104.30", "104.3", "104.31", "104.32", "104.33", "104.34",
+	"104.35", "104.36", "104.37", "104.38", "104.39", "104.40", "104.4", "104.41", "104.42", "104.43", "104.44", "104.45", "104.46", "104.47", "104.48", "104.49",
+	"104.50", "104.5", "104.51", "104.52", "104.53", "104.54", "104.55", "104.56", "104.57", "104.58", "104.59", "104.60", "104.6", "104.61", "104.62", "104.63",
+	"104.64", "104.65", "104.66", "104.67", "104.68", "104.69", "104.70", "104.7", "104.71", "104.72", "104.73", "104.74", "104.75", "104.76", "104.77", "104.78",
+	"104.79", "104.80", "104.8", "104.81", "104.82", "104.83", "104.84", "104.85", "104.86", "104.87", "104.88", "104.89", "104.90", "104.9", "104.91", "104.92",
+	"104.93", "104.94", "104.95", "104.96", "104.97", "104.98", "104.99", "105.00", "105.0", "105.01", "105.02", "105.03", "105.04", "105.05", "105.06", "105.07",
+	"105.08", "105.09", "105.10", "105.1", "105.11", "105.12", "105.13", "105.14", "105.15", "105.16", "105.17", "105.18", "105.19", "105.20", "105.2", "105.21",
+	"105.22", "105.23", "105.24", "105.25", "105.26", "105.27", "105.28", "105.29", "105.30", "105.3", "105.31", "105.32", "105.33", "105.34", "105.35", "105.36",
+	"105.37", "105.38", "105.39", "105.40", "105.4", "105.41", "105.42", "105.43", "105.44", "105.45", "105.46", "105.47", "105.48", "105.49", "105.50", "105.5",
+	"105.51", "105.52", "105.53", "105.54", "105.55", "105.56", "105.57", "105.58", "105.59", "105.60", "105.6", "105.61", "105.62", "105.63", "105.64", "105.65",
+	"105.66", "105.67", "105.68", "105.69", "105.70", "105.7", "105.71", "105.72", "105.73", "105.74", "105.75", "105.76", "105.77", "105.78", "105.79", "105.80",
+	"105.8", "105.81", "105.82", "105.83", "105.84", "105.85", "105.86", "105.87", "105.88", "105.89", "105.90", "105.9", "105.91", "105.92", "105.93", "105.94",
+	"105.95", "105.96", "105.97", "105.98", "105.99", "106.00", "106.0", "106.01", "106.02", "106.03", "106.04", "106.05", "106.06", "106.07", "106.08", "106.09",
+	"106.10", "106.1", "106.11", "106.12", "106.13", "106.14", "106.15", "106.16", "106.17", "106.18", "106.19", "106.20", "106.2", "106.21", "106.22", "106.23",
+	"106.24", "106.25", "106.26", "106.27", "106.28", "106.29", "106.30", "106.3", "106.31", "106.32", "106.33", "106.34", "106.35", "106.36", "106.37", "106.38",
+	"106.39", "106.40", "106.4", "106.41", "106.42", "106.43", "106.44", "106.45", "106.46", "106.47", "106.48", "106.49", "106.50", "106.5", "106.51", "106.52",
+	"106.53", "106.54", "106.55", "106.56", "106.57", "106.58", "106.59", "106.60", "106.6", "106.61", "106.62", "106.63", "106.64", "106.65", "106.66", "106.67",
+	"106.68", "106.69", "106.70", "106.7", "106.71", "106.72", "106.73", "106.74", "106.75", "106.76", "106.77", "106.78", "106.79", "106.80", "106.8", "106.81",
+	"106.82", "106.83", "106.84", "106.85", "106.86", "106.87", "106.88", "106.89", "106.90", "106.9", "106.91", "106.92", "106.93", "106.94", "106.95", "106.96",
+	"106.97", "106.98", "106.99", "107.00", "107.0", "107.01", "107.02", "107.03", "107.04", "107.05", "107.06", "107.07", "107.08", "107.09", "107.10", "107.1",
+	"107.11", "107.12", "107.13", "107.14", "107.15", "107.16", "107.17", "107.18", "107.19", "107.20", "107.2", "107.21", "107.22", "107.23", "107.24", "107.25",
+	"107.26", "107.27", "107.28", "107.29", "107.30", "107.3", "107.31", "107.32", "107.33", "107.34", "107.35", "107.36", "107.37", "107.38", "107.39", "107.40",
+	"107.4", "107.41", "107.42", "107.43", "107.44", "107.45", "107.46", "107.47", "107.48", "107.49", "107.50", "107.5", "107.51", "107.52", "107.53", "107.54",
+	"107.55", "107.56", "107.57", "107.58", "107.59", "107.60", "107.6", "107.61", "107.62", "107.63", "107.64", "107.65", "107.66", "107.67", "107.68", "107.69",
+	"107.70", "107.7", "107.71", "107.72", "107.73", "107.74", "107.75", "107.76", "107.77", "107.78", "107.79", "107.80", "107.8", "107.81", "107.82", "107.83",
+	"107.84", "107.85", "107.86", "107.87", "107.88", "107.89", "107.90", "107.9", "107.91", "107.92", "107.93", "107.94", "107.95", "107.96", "107.97", "107.98",
+	"107.99", "108.00", "108.0", "108.01", "108.02", "108.03", "108.04", "108.05", "108.06", "108.07", "108.08", "108.09", "108.10", "108.1", "108.11", "108.12",
+	"108.13", "108.14", "108.15", "108.16", "108.17", "108.18", "108.19", "108.20", "108.2", "108.21", "108.22", "108.23", "108.24", "108.25", "108.26", "108.27",
+	"108.28", "108.29", "108.30", "108.3", "108.31", "108.32", "108.33", "108.34", "108.35", "108.36", "108.37", "108.38", "108.39", "108.40", "108.4", "108.41",
+	"108.42", "108.43", "108.44", "108.45", "108.46", "108.47", "108.48", "108.49", "108.50", "108.5", "108.51", "108.52", "108.53", "108.54", "108.55", "108.56",
+	"108.57", "108.58", "108.59", "108.60", "108.6", "108.61", "108.62", "108.63", "108.64", "108.65", "108.66", "108.67", "108.68", "108.69", "108.70", "108.7",
+	"108.71", "108.72", "108.73", "108.74", "108.75", "108.76", "108.77", "108.78", "108.79", "108.80", "108.8", "108.81", "108.82", "108.83", "108.84", "108.85",
+	"108.86", "108.87", "108.88", "108.89", "108.90", "108.9", "108.91", "108.92", "108.93", "108.94", "108.95", "108.96", "108.97", "108.98", "108.99", "109.00",
+	"109.0", "109.01", "109.02", "109.03", "109.04", "109.05", "109.06", "109.07", "109.08", "109.09", "109.10", "109.1", "109.11", "109.12", "109.13", "109.14",
+	"109.15", "109.16", "109.17", "109.18", "109.19", "109.20", "109.2", "109.21", "109.22", "109.23", "109.24", "109.25", "109.26", "109.27", "109.28", "109.29",
+	"109.30", "109.3", "109.31", "109.32", "109.33", "109.34", "109.35", "109.36", "109.37", "109.38", "109.39", "109.40", "109.4", "109.41", "109.42", "109.43",
+	"109.44", "109.45", "109.46", "109.47", "109.48", "109.49", "109.50", "109.5", "109.51", "109.52", "109.53", "109.54", "109.55", "109.56", "109.57", "109.58",
+	"109.59", "109.60", "109.6", "109.61", "109.62", "109.63", "109.64", "109.65", "109.66", "109.67", "109.68", "109.69", "109.70", "109.7", "109.71", "109.72",
+	"109.73", "109.74", "109.75", "109.76", "109.77", "109.78", "109.79", "109.80", "109.8", "109.81", "109.82", "109.83", "109.84", "109.85", "109.86", "109.87",
+	"109.88", "109.89", "109.90", "109.9", "109.91", "109.92", "109.93", "109.94", "109.95", "109.96", "109.97", "109.98", "109.99", "110.00", "110.0", "110.01",
+	"110.02", "110.03", "110.04", "110.05", "110.06", "110.07", "110.08", "110.09", "110.10", "110.1", "110.11", "110.12", "110.13", "110.14", "110.15", "110.16",
+	"110.17", "110.18", "110.19", "110.20", "110.2", "110.21", "110.22", "110.23", "110.24", "110.25", "110.26", "110.27", "110.28", "110.29", "110.30", "110.3",
+	"110.31", "110.32", "110.33", "110.34", "110.35", "110.36", "110.37", "110.38", "110.39", "110.40", "110.4", "110.41", "110.42", "110.43", "110.44", "110.45",
+	"110.46", "110.47", "110.48", "110.49", "110.50", "110.5", "110.51", "110.52", "110.53", "110.54", "110.55", "110.56", "110.57", "110.58", "110.59", "110.60",
+	"110.6", "110.61", "110.62", "110.63", "110.64", "110.65", "110.66", "110.67", "110.68", "110.69", "110.70", "110.7", "110.71", "110.72", "110.73", "110.74",
+	"110.75", "110.76", "110.77", "110.78", "110.79", "110.80", "110.8", "110.81", "110.82", "110.83", "110.84", "110.85", "110.86", "110.87", "110.88", "110.89",
+	"110.90", "110.9", "110.91", "110.92", "110.93", "110.94", "110.95", "110.96", "110.97", "110.98", "110.99", "111.00", "111.0", "111.01", "111.02", "111.03",
+	"111.04", "111.05", "111.06", "111.07", "111.08", "111.09", "111.10", "111.1", "111.11", "111.12", "111.13", "111.14", "111.15", "111.16", "111.17", "111.18",
+	"111.19", "111.20", "111.2", "111.21", "111.22", "111.23", "111.24", "111.25", "111.26", "111.27", "111.28", "111.29", "111.30", "111.3", "111.31", "111.32",
+	"111.33", "111.34", "111.35", "111.36", "111.37", "111.38", "111.39", "111.40", "111.4", "111.41", "111.42", "111.43", "111.44", "111.45", "111.46", "111.47",
+	"111.48", "111.49", "111.50", "111.5", "111.51", "111.52", "111.53", "111.54", "111.55", "111.56", "111.57", "111.58", "111.59", "111.60", "111.6", "111.61",
+	"111.62", "111.63", "111.64", "111.65", "111.66", "111.67", "111.68", "111.69", "111.70", "111.7", "111.71", "111.72", "111.73", "111.74", "111.75", "111.76",
+	"111.77", "111.78", "111.79", "111.80", "111.8", "111.81", "111.82", "111.83", "111.84", "111.85", "111.86", "111.87", "111.88", "111.89", "111.90", "111.9",
+	"111.91", "111.92", "111.93", "111.94", "111.95", "111.96", "111.97", "111.98", "111.99", "112.00", "112.0", "112.01", "112.02", "112.03", "112.04", "112.05",
+	"112.06", "112.07", "112.08", "112.09", "112.10", "112.1", "112.11", "112.12", "112.13", "112.14", "112.15", "112.16", "112.17", "112.18", "112.19", "112.20",
+	"112.2", "112.21", "112.22", "112.23", "112.24", "112.25", "112.26", "112.27", "112.28", "112.29", "112.30", "112.3", "112.31", "112.32", "112.33", "112.34",
+	"112.35", "112.36", "112.37", "112.38", "112.39", "112.40", "112.4", "112.41", "112.42", "112.43", "112.44", "112.45", "112.46", "112.47", "112.48", "112.49",
+	"112.50", "112.5", "112.51", "112.52", "112.53", "112.54", "112.55", "112.56", "112.57", "112.58", "112.59", "112.60", "112.6", "112.61", "112.62", "112.63",
+	"112.64", "112.65", "112.66", "112.67", "112.68", "112.69", "112.70", "112.7", "112.71", "112.72", "112.73", "112.74", "112.75", "112.76", "112.77", "112.78",
+	"112.79", "112.80", "112.8", "112.81", "112.82", "112.83", "112.84", "112.85", "112.86", "112.87", "112.88", "112.89", "112.90", "112.9", "112.91", "112.92",
+	"112.93", "112.94", "112.95", "112.96", "112.97", "112.98", "112.99", "113.00", "113.0", "113.01", "113.02", "113.03", "113.04", "113.05", "113.06", "113.07",
+	"113.08", "113.09", "113.10", "113.1", "113.11", "113.12", "113.13", "113.14", "113.15", "113.16", "113.17", "113.18", "113.19", "113.20", "113.2", "113.21",
+	"113.22", "113.23", "113.24", "113.25", "113.26", "113.27", "113.28", "113.29", "113.30", "113.3", "113.31", "113.32", "113.33", "113.34", "113.35", "113.36",
+	"113.37", "113.38", "113.39", "113.40", "113.4", "113.41", "113.42", "113.43", "113.44", "113.45", "113.46", "113.47", "113.48", "113.49", "113.50", "113.5",
+	"113.51", "113.52", "113.53", "113.54", "113.55", "113.56", "113.57", "113.58", "113.59", "113.60", "113.6", "113.61", "113.62", "113.63", "113.64", "113.65",
+	"113.66", "113.67", "113.68", "113.69", "113.70", "113.7", "113.71", "113.72", "113.73", "113.74", "113.75", "113.76", "113.77", "113.78", "113.79", "113.80",
+	"113.8", "113.81", "113.82", "113.83", "113.84", "113.85", "113.86", "113.87", "113.88", "113.89", "113.90", "113.9", "113.91", "113.92", "113.93", "113.94",
+	"113.95", "113.96", "113.97", "113.98", "113.99", "114.00", "114.0", "114.01", "114.02", "114.03", "114.04", "114.05", "114.06", "114.07", "114.08", "114.09",
+	"114.10", "114.1", "114.11", "114.12", "114.13", "114.14", "114.15", "114.16", "114.17", "114.18", "114.19", "114.20", "114.2", "114.21", "114.22", "114.23",
+	"114.24", "114.25", "114.26", "114.27", "114.28", "114.29", "114.30", "114.3", "114.31", "114.32", "114.33", "114.34", "114.35", "114.36", "114.37", "114.38",
+	"114.39", "114.40", "114.4", "114.41", "114.42", "114.43", "114.44", "114.45", "114.46", "114.47", "114.48", "114.49", "114.50", "114.5", "114.51", "114.52",
+	"114.53", "114.54", "114.55", "114.56", "114.57", "114.58", "114.59", "114.60", "114.6", "114.61", "114.62", "114.63", "114.64", "114.65", "114.66", "114.67",
+	"114.68", "114.69", "114.70", "114.7", "114.71", "114.72", "114.73", "114.74", "114.75", "114.76", "114.77", "114.78", "114.79", "114.80", "114.8", "114.81",
+	"114.82", "114.83", "114.84", "114.85", "114.86", "114.87", "114.88", "114.89", "114.90", "114.9", "114.91", "114.92", "114.93", "114.94", "114.95", "114.96",
+	"114.97", "114.98", "114.99", "115.00", "115.0", "115.01", "115.02", "115.03", "115.04", "115.05", "115.06", "115.07", "115.08", "115.09", "115.10", "115.1",
+	"115.11", "115.12", "115.13", "115.14", "115.15", "115.16", "115.17", "115.18", "115.19", "115.20", "115.2", "115.21", "115.22", "115.23", "115.24", "115.25",
+	"115.26", "115.27", "115.28", "115.29", "115.30", "115.3", "115.31", "115.32", "115.33", "115.34", "115.35", "115.36", "115.37", "115.38", "115.39", "115.40",
+	"115.4", "115.41", "115.42", "115.43", "115.44", "115.45", "115.46", "115.47", "115.48", "115.49", "115.50", "115.5", "115.51", "115.52", "115.53", "115.54",
+	"115.55", "115.56", "115.57", "115.58", "115.59", "115.60", "115.6", "115.61", "115.62", "115.63", "115.64", "115.65", "115.66", "115.67", "115.68", "115.69",
+	"115.70", "115.7", "115.71", "115.72", "115.73", "115.74", "115.75", "115.76", "115.77", "115.78", "115.79", "115.80", "115.8", "115.81", "115.82", "115.83",
+	"115.84", "115.85", "115.86", "115.87", "115.88", "115.89", "115.90", "115.9", "115.91", "115.92", "115.93", "115.94", "115.95", "115.96", "115.97", "115.98",
+	"115.99", "116.00", "116.0", "116.01", "116.02", "116.03", "116.04", "116.05", "116.06", "116.07", "116.08", "116.09", "116.10", "116.1", "116.11", "116.12",
+	"116.13", "116.14", "116.15", "116.16", "116.17", "116.18", "116.19", "116.20", "116.2", "116.21", "116.22", "116.23", "116.24", "116.25", "116.26", "116.27",
+	"116.28", "116.29", "116.30", "116.3", "116.31", "116.32", "116.33", "116.34", "116.35", "116.36", "116.37", "116.38", "116.39", "116.40", "116.4", "116.41",
+	"116.42", "116.43", "116.44", "116.45", "116.46", "116.47", "116.48", "116.49", "116.50", "116.5", "116.51", "116.52", "116.53", "116.54", "116.55", "116.56",
+	"116.57", "116.58", "116.59", "116.60", "116.6", "116.61", "116.62", "116.63", "116.64", "116.65", "116.66", "116.67", "116.68", "116.69", "116.70", "116.7",
+	"116.71", "116.72", "116.73", "116.74", "116.75", "116.76", "116.77", "116.78", "116.79", "116.80", "116.8", "116.81", "116.82", "116.83", "116.84", "116.85",
+	"116.86", "116.87", "116.88", "116.89", "116.90", "116.9", "116.91", "116.92", "116.93", "116.94", "116.95", "116.96", "116.97", "116.98", "116.99", "117.00",
+	"117.0", "117.01", "117.02", "117.03", "117.04", "117.05", "117.06", "117.07", "117.08", "117.09", "117.10", "117.1", "117.11", "117.12", "117.13", "117.14",
+	"117.15", "117.16", "117.17", "117.18", "117.19", "117.20", "117.2", "117.21", "117.22", "117.23", "117.24", "117.25", "117.26", "117.27", "117.28", "117.29",
+	"117.30", "117.3", "117.31", "117.32", "117.33", "117.34", "117.35", "117.36", "117.37", "117.38", "117.39", "117.40", "117.4", "117.41", "117.42", "117.43",
+	"117.44", "117.45", "117.46", "117.47", "117.48", "117.49", "117.50", "117.5", "117.51", "117.52", "117.53", "117.54", "117.55", "117.56", "117.57", "117.58",
+	"117.59", "117.60", "117.6", "117.61", "117.62", "117.63", "117.64", "117.65", "117.66", "117.67", "117.68", "117.69", "117.70", "117.7", "117.71", "117.72",
+	"117.73", "117.74", "117.75", "117.76", "117.77", "117.78", "117.79", "117.80", "117.8", "117.81", "117.82", "117.83", "117.84", "117.85", "117.86", "117.87",
+	"117.88", "117.89", "117.90", "117.9", "117.91", "117.92", "117.93", "117.94", "117.95", "117.96", "117.97", "117.98", "117.99", "118.00", "118.0", "118.01",
+	"118.02", "118.03", "118.04", "118.05", "118.06", "118.07", "118.08", "118.09", "118.10", "118.1", "118.11", "118.12", "118.13", "118.14", "118.15", "118.16",
+	"118.17", "118.18", "118.19", "118.20", "118.2", "118.21", "118.22", "118.23", "118.24", "118.25", "118.26", "118.27", "118.28", "118.29", "118.30", "118.3",
+	"118.31", "118.32", "118.33", "118.34", "118.35", "118.36", "118.37", "118.38", "118.39", "118.40", "118.4", "118.41", "118.42", "118.43", "118.44", "118.45",
+	"118.46", "118.47", "118.48", "118.49", "118.50", "118.5", "118.51", "118.52", "118.53", "118.54", "118.55", "118.56", "118.57", "118.58", "118.59", "118.60",
+	"118.6", "118.61", "118.62", "118.63", "118.64", "118.65", "118.66", "118.67", "118.68", "118.69", "118.70", "118.7", "118.71", "118.72", "118.73", "118.74",
+	"118.75", "118.76", "118.77", "118.78", "118.79", "118.80", "118.8", "118.81", "118.82", "118.83", "118.84", "118.85", "118.86", "118.87", "118.88", "118.89",
+	"118.90", "118.9", "118.91", "118.92", "118.93", "118.94", "118.95", "118.96", "118.97", "118.98", "118.99", "119.00", "119.0", "119.01", "119.02", "119.03",
+	"119.04", "119.05", "119.06", "119.07", "119.08", "119.09", "119.10", "119.1", "119.11", "119.12", "119.13", "119.14", "119.15", "119.16", "119.17", "119.18",
+	"119.19", "119.20", "119.2", "119.21", "119.22", "119.23", "119.24", "119.25", "119.26", "119.27", "119.28", "119.29", "119.30", "119.3", "119.31", "119.32",
+	"119.33", "119.34", "119.35", "119.36", "119.37", "119.38", "119.39", "119.40", "119.4", "119.41", "119.42", "119.43", "119.44", "119.45", "119.46", "119.47",
+	"119.48", "119.49", "119.50", "119.5", "119.51", "119.52", "119.53", "119.54", "119.55", "119.56", "119.57", "119.58", "119.59", "119.60", "119.6", "119.61",
+	"119.62", "119.63", "119.64", "119.65", "119.66", "119.67", "119.68", "119.69", "119.70", "119.7", "119.71", "119.72", "119.73", "119.74", "119.75", "119.76",
+	"119.77", "119.78", "119.79", "119.80", "119.8", "119.81", "119.82", "119.83", "119.84", "119.85", "119.86", "119.87", "119.88", "119.89", "119.90", "119.9",
+	"119.91", "119.92", "119.93", "119.94", "119.95", "119.96", "119.97", "119.98", "119.99", "120.00", "120.0", "120.01", "120.02", "120.03", "120.04", "120.05",
+	"120.06", "120.07", "120.08", "120.09", "120.10", "120.1", "120.11", "120.12", "120.13", "120.14", "120.15", "120.16", "120.17", "120.18", "120.19", "120.20",
+	"120.2", "120.21", "120.22", "120.23", "120.24", "120.25", "120.26", "120.27", "120.28", "120.29", "120.30", "120.3", "120.31", "120.32", "120.33", "120.34",
+	"120.35", "120.36", "120.37", "120.38", "120.39", "120.40", "120.4", "120.41", "120.42", "120.43", "120.44", "120.45", "120.46", "120.47", "120.48", "120.49",
+	"120.50", "120.5", "120.51", "120.52", "120.53", "120.54", "120.55", "120.56", "120.57", "120.58", "120.59", "120.60", "120.6", "120.61", "120.62", "120.63",
+	"120.64", "120.65", "120.66", "120.67", "120.68", "120.69", "120.70", "120.7", "120.71", "120.72", "120.73", "120.74", "120.75", "120.76", "120.77", "120.78",
+	"120.79", "120.80", "120.8", "120.81", "120.82", "120.83", "120.84", "120.85", "120.86", "120.87", "120.88", "120.89", "120.90", "120.9", "120.91", "120.92",
+	"120.93", "120.94", "120.95", "120.96", "120.97", "120.98", "120.99", "0.000", "0.001", "0.002", "0.003", "0.004", "0.005", "0.006", "0.007", "0.008",
+	"0.009", "0.010", "0.011", "0.012", "0.013", "0.014", "0.015", "0.016", "0.017", "0.018", "0.019", "0.020", "0.021", "0.022", "0.023", "0.024",
+	"0.025", "0.026", "0.027", "0.028", "0.029", "0.030", "0.031", "0.032", "0.033", "0.034", "0.035", "0.036", "0.037", "0.038", "0.039", "0.040",
+	"0.041", "0.042", "0.043", "0.044", "0.045", "0.046", "0.047", "0.048", "0.049", "0.050", "0.051", "0.052", "0.053", "0.054", "0.055", "0.056",
+	"0.057", "0.058", "0.059", "0.060", "0.061", "0.062", "0.063", "0.064", "0.065", "0.066", "0.067", "0.068", "0.069", "0.070", "0.071", "0.072",
+	"0.073", "0.074", "0.075", "0.076", "0.077", "0.078", "0.079", "0.080", "0.081", "0.082", "0.083", "0.084", "0.085", "0.086", "0.087", "0.088",
+	"0.089", "0.090", "0.091", "0.092", "0.093", "0.094", "0.095", "0.096", "0.097", "0.098", "0.099", "0.100", "0.101", "0.102", "0.103", "0.104",
+	"0.105", "0.106", "0.107", "0.108", "0.109", "0.110", "0.111", "0.112", "0.113", "0.114", "0.115", "0.116", "0.117", "0.118", "0.119", "0.120",
+	"0.121", "0.122", "0.123", "0.124", "0.125", "0.126", "0.127", "0.128", "0.129", "0.130", "0.131", "0.132", "0.133", "0.134", "0.135", "0.136",
+	"0.137", "0.138", "0.139", "0.140", "0.141", "0.142", "0.143", "0.144", "0.145", "0.146", "0.147", "0.148", "0.149", "0.150", "0.151", "0.152",
+	"0.153", "0.154", "0.155", "0.156", "0.157", "0.158", "0.159", "0.160", "0.161", "0.162", "0.163", "0.164", "0.165", "0.166", "0.167", "0.168",
+	"0.169", "0.170", "0.171", "0.172", "0.173", "0.174", "0.175", "0.176", "0.177", "0.178", "0.179", "0.180", "0.181", "0.182", "0.183", "0.184",
+	"0.185", "0.186", "0.187", "0.188", "0.189", "0.190", "0.191", "0.192", "0.193", "0.194", "0.195", "0.196", "0.197", "0.198", "0.199", "0.200",
+	"0.201", "0.202", "0.203", "0.204", "0.205", "0.206", "0.207", "0.208", "0.209", "0.210", "0.211", "0.212", "0.213", "0.214", "0.215", "0.216",
+	"0.217", "0.218", "0.219", "0.220", "0.221", "0.222", "0.223", "0.224", "0.225", "0.226", "0.227", "0.228", "0.229", "0.230", "0.231", "0.232",
+	"0.233", "0.234", "0.235", "0.236", "0.237", "0.238", "0.239", "0.240", "0.241", "0.242", "0.243", "0.244", "0.245", "0.246", "0.247", "0.248",
+	"0.249", "0.250", "0.251", "0.252", "0.253", "0.254", "0.255", "0.256", "0.257", "0.258", "0.259", "0.260", "0.261", "0.262", "0.263", "0.264",
+	"0.265", "0.266", "0.267", "0.268", "0.269", "0.270", "0.271", "0.272", "0.273", "0.274", "0.275", "0.276", "0.277", "0.278", "0.279", "0.280",
+	"0.281", "0.282", "0.283", "0.284", "0.285", "0.286", "0.287", "0.288", "0.289", "0.290", "0.291", "0.292", "0.293", "0.294", "0.295", "0.296",
+	"0.297", "0.298", "0.299", "0.300", "0.301", "0.302", "0.303", "0.304", "0.305", "0.306", "0.307", "0.308", "0.309", "0.310", "0.311", "0.312",
+	"0.313", "0.314", "0.315", "0.316", "0.317", "0.318", "0.319", "0.320", "0.321", "0.322", "0.323", "0.324", "0.325", "0.326", "0.327", "0.328",
+	"0.329", "0.330", "0.331", "0.332", "0.333", "0.334", "0.335", "0.336", "0.337", "0.338", "0.339", "0.340", "0.341", "0.342", "0.343", "0.344",
+	"0.345", "0.346", "0.347", "0.348", "0.349", "0.350", "0.351", "0.352", "0.353", "0.354", "0.355", "0.356", "0.357", "0.358", "0.359", "0.360",
+	"0.361", "0.362", "0.363", "0.364", "0.365", "0.366", "0.367", "0.368", "0.369", "0.370", "0.371", "0.372", "0.373", "0.374", "0.375", "0.376",
+	"0.377", "0.378", "0.379", "0.380", "0.381", "0.382", "0.383", "0.384", "0.385", "0.386", "0.387", "0.388", "0.389", "0.390", "0.391", "0.392",
+	"0.393", "0.394", "0.395", "0.396", "0.397", "0.398", "0.399", "0.400", "0.401", "0.402", "0.403", "0.404", "0.405", "0.406", "0.407", "0.408",
+	"0.409", "0.410", "0.411", "0.412", "0.413", "0.414", "0.415", "0.416", "0.417", "0.418", "0.419", "0.420", "0.421", "0.422", "0.423", "0.424",
+	"0.425", "0.426", "0.427", "0.428", "0.429", "0.430", "0.431", "0.432", "0.433", "0.434", "0.435", "0.436", "0.437", "0.438", "0.439", "0.440",
+	"0.441", "0.442", "0.443", "0.444", "0.445", "0.446", "0.447", "0.448", "0.449", "0.450", "0.451", "0.452", "0.453", "0.454", "0.455", "0.456",
+	"0.457", "0.458", "0.459", "0.460", "0.461", "0.462", "0.463", "0.464", "0.465", "0.466", "0.467", "0.468", "0.469", "0.470", "0.471", "0.472",
+	"0.473", "0.474", "0.475", "0.476", "0.477", "0.478", "0.479", "0.480", "0.481", "0.482", "0.483", "0.484", "0.485", "0.486", "0.487", "0.488",
+	"0.489", "0.490", "0.491", "0.492", "0.493", "0.494", "0.495", "0.496", "0.497", "0.498", "0.499", "0.500", "0.501", "0.502", "0.503", "0.504",
+	"0.505", "0.506", "0.507", "0.508", "0.509", "0.510", "0.511", "0.512", "0.513", "0.514", "0.515", "0.516", "0.517", "0.518", "0.519", "0.520",
+	"0.521", "0.522", "0.523", "0.524", "0.525", "0.526", "0.527", "0.528", "0.529", "0.530", "0.531", "0.532", "0.533", "0.534", "0.535", "0.536",
+	"0.537", "0.538", "0.539", "0.540", "0.541", "0.542", "0.543", "0.544", "0.545", "0.546", "0.547", "0.548", "0.549", "0.550", "0.551", "0.552",
+	"0.553", "0.554", "0.555", "0.556", "0.557", "0.558", "0.559", "0.560", "0.561", "0.562", "0.563", "0.564", "0.565", "0.566", "0.567", "0.568",
+	"0.569", "0.570", "0.571", "0.572", "0.573", "0.574", "0.575", "0.576", "0.577", "0.578", "0.579", "0.580", "0.581", "0.582", "0.583", "0.584",
+	"0.585", "0.586", "0.587", "0.588", "0.589", "0.590", "0.591", "0.592", "0.593", "0.594", "0.595", "0.596", "0.597", "0.598", "0.599", "0.600",
+	"0.601", "0.602", "0.603", "0.604", "0.605", "0.606", "0.607", "0.608", "0.609", "0.610", "0.611", "0.612", "0.613", "0.614", "0.615", "0.616",
+	"0.617", "0.618", "0.619", "0.620", "0.621", "0.622", "0.623", "0.624", "0.625", "0.626", "0.627", "0.628", "0.629", "0.630", "0.631", "0.632",
+	"0.633", "0.634", "0.635", "0.636", "0.637", "0.638", "0.639", "0.640", "0.641", "0.642", "0.643", "0.644", "0.645", "0.646", "0.647", "0.648",
+	"0.649", "0.650", "0.651", "0.652", "0.653", "0.654", "0.655", "0.656", "0.657", "0.658", "0.659", "0.660", "0.661", "0.662", "0.663", "0.664",
+	"0.665", "0.666", "0.667", "0.668", "0.669", "0.670", "0.671", "0.672", "0.673", "0.674", "0.675", "0.676", "0.677", "0.678", "0.679", "0.680",
+	"0.681", "0.682", "0.683", "0.684", "0.685", "0.686", "0.687", "0.688", "0.689", "0.690", "0.691", "0.692", "0.693", "0.694", "0.695", "0.696",
+	"0.697", "0.698", "0.699", "0.700", "0.701", "0.702", "0.703", "0.704", "0.705", "0.706", "0.707", "0.708", "0.709", "0.710", "0.711", "0.712",
+	"0.713", "0.714", "0.715", "0.716", "0.717", "0.718", "0.719", "0.720", "0.721", "0.722", "0.723", "0.724", "0.725", "0.726", "0.727", "0.728",
+	"0.729", "0.730", "0.731", "0.732", "0.733", "0.734", "0.735", "0.736", "0.737", "0.738", "0.739", "0.740", "0.741", "0.742", "0.743", "0.744",
+	"0.745", "0.746", "0.747", "0.748", "0.749", "0.750", "0.751", "0.752", "0.753", "0.754", "0.755", "0.756", "0.757", "0.758", "0.759", "0.760",
+	"0.761", "0.762", "0.763", "0.764", "0.765", "0.766", "0.767", "0.768", "0.769", "0.770", "0.771", "0.772", "0.773", "0.774", "0.775", "0.776",
+	"0.777", "0.778", "0.779", "0.780", "0.781", "0.782", "0.783", "0.784", "0.785", "0.786", "0.787", "0.788", "0.789", "0.790", "0.791", "0.792",
+	"0.793", "0.794", "0.795", "0.796", "0.797", "0.798", "0.799", "0.800", "0.801", "0.802", "0.803", "0.804", "0.805", "0.806", "0.807", "0.808",
+	"0.809", "0.810", "0.811", "0.812", "0.813", "0.814", "0.815", "0.816", "0.817", "0.818", "0.819", "0.820", "0.821", "0.822", "0.823", "0.824",
+	"0.825", "0.826", "0.827", "0.828", "0.829", "0.830", "0.831", "0.832", "0.833", "0.834", "0.835", "0.836", "0.837", "0.838", "0.839", "0.840",
+	"0.841", "0.842", "0.843", "0.844", "0.845", "0.846", "0.847", "0.848", "0.849", "0.850", "0.851", "0.852", "0.853", "0.854", "0.855", "0.856",
+	"0.857", "0.858", "0.859", "0.860", "0.861", "0.862", "0.863", "0.864", "0.865", "0.866", "0.867", "0.868", "0.869", "0.870", "0.871", "0.872",
+	"0.873", "0.874", "0.875", "0.876", "0.877", "0.878", "0.879", "0.880", "0.881", "0.882", "0.883", "0.884", "0.885", "0.886", "0.887", "0.888",
+	"0.889", "0.890", "0.891", "0.892", "0.893", "0.894", "0.895", "0.896", "0.897", "0.898", "0.899", "0.900", "0.901", "0.902", "0.903", "0.904",
+	"0.905", "0.906", "0.907", "0.908", "0.909", "0.910", "0.911", "0.912", "0.913", "0.914", "0.915", "0.916", "0.917", "0.918", "0.919", "0.920",
+	"0.921", "0.922", "0.923", "0.924", "0.925", "0.926", "0.927", "0.928", "0.929", "0.930", "0.931", "0.932", "0.933", "0.934", "0.935", "0.936",
+	"0.937", "0.938", "0.939", "0.940", "0.941", "0.942", "0.943", "0.944", "0.945", "0.946", "0.947", "0.948", "0.949", "0.950", "0.951", "0.952",
+	"0.953", "0.954", "0.955", "0.956", "0.957", "0.958", "0.959", "0.960", "0.961", "0.962", "0.963", "0.964", "0.965", "0.966", "0.967", "0.968",
+	"0.969", "0.970", "0.971", "0.972", "0.973", "0.974", "0.975", "0.976", "0.977", "0.978", "0.979", "0.980", "0.981", "0.982", "0.983", "0.984",
+	"0.985", "0.986", "0.987", "0.988", "0.989", "0.990", "0.991", "0.992", "0.993", "0.994", "0.995", "0.996", "0.997", "0.998", "0.999", "1.000",
+	"1.001", "1.002", "1.003", "1.004", "1.005", "1.006", "1.007", "1.008", "1.009", "1.010", "1.011", "1.012", "1.013", "1.014", "1.015", "1.016",
+	"1.017", "1.018", "1.019", "1.020", "1.021", "1.022", "1.023", "1.024", "1.025", "1.026", "1.027", "1.028", "1.029", "1.030", "1.031", "1.032",
+	"1.033", "1.034", "1.035", "1.036", "1.037", "1.038", "1.039", "1.040", "1.041", "1.042", "1.043", "1.044", "1.045", "1.046", "1.047", "1.048",
+	"1.049", "1.050", "1.051", "1.052", "1.053", "1.054", "1.055", "1.056", "1.057", "1.058", "1.059", "1.060", "1.061", "1.062", "1.063", "1.064",
+	"1.065", "1.066", "1.067", "1.068", "1.069", "1.070", "1.071", "1.072", "1.073", "1.074", "1.075", "1.076", "1.077", "1.078", "1.079", "1.080",
+	"1.081", "1.082", "1.083", "1.084", "1.085", "1.086", "1.087", "1.088", "1.089", "1.090", "1.091", "1.092", "1.093", "1.094", "1.095", "1.096",
+	"1.097", "1.098", "1.099", "1.100", "1.101", "1.102", "1.103", "1.104", "1.105", "1.106", "1.107", "1.108", "1.109", "1.110", "1.111", "1.112",
+	"1.113", "1.114", "1.115", "1.116", "1.117", "1.118", "1.119", "1.120", "1.121", "1.122", "1.123", "1.124", "1.125", "1.126", "1.127", "1.128",
+	"1.129", "1.130", "1.131", "1.132", "1.133", "1.134", "1.135", "1.136", "1.137", "1.138", "1.139", "1.140", "1.141", "1.142", "1.143", "1.144",
+	"1.145", "1.146", "1.147", "1.148", "1.149", "1.150", "1.151", "1.152", "1.153", "1.154", "1.155", "1.156", "1.157", "1.158", "1.159", "1.160",
+	"1.161", "1.162", "1.163", "1.164", "1.165", "1.166", "1.167", "1.168", "1.169", "1.170", "1.171", "1.172", "1.173", "1.174", "1.175", "1.176",
+	"1.177", "1.178", "1.179", "1.180", "1.181", "1.182", "1.183", "1.184", "1.185", "1.186", "1.187", "1.188", "1.189", "1.190", "1.191", "1.192",
+	"1.193", "1.194", "1.195", "1.196", "1.197", "1.198", "1.199", "1.200", "1.201", "1.202", "1.203", "1.204", "1.205", "1.206", "1.207", "1.208",
+	"1.209", "1.210", "1.211", "1.212", "1.213", "1.214", "1.215", "1.216", "1.217", "1.218", "1.219", "1.220", "1.221", "1.222", "1.223", "1.224",
+	"1.225", "1.226", "1.227", "1.228", "1.229", "1.230", "1.231", "1.232", "1.233", "1.234", "1.235", "1.236", "1.237", "1.238", "1.239", "1.240",
+	"1.241", "1.242", "1.243", "1.244", "1.245", "1.246", "1.247", "1.248", "1.249", "1.250", "1.251", "1.252", "1.253", "1.254", "1.255", "1.256",
+	"1.257", "1.258", "1.259", "1.260", "1.261", "1.262", "1.263", "1.264", "1.265", "1.266", "1.267", "1.268", "1.269", "1.270", "1.271", "1.272",
+	"1.273", "1.274", "1.275", "1.276", "1.277", "1.278", "1.279", "1.280", "1.281", "1.282", "1.283", "1.284", "1.285", "1.286", "1.287", "1.288",
+	"1.289", "1.290", "1.291", "1.292", "1.293", "1.294", "1.295", "1.296", "1.297", "1.298", "1.299", "1.300", "1.301", "1.302", "1.303", "1.304",
+	"1.305", "1.306", "1.307", "1.308", "1.309", "1.310", "1.311", "1.312", "1.313", "1.314", "1.315", "1.316", "1.317", "1.318", "1.319", "1.320",
+	"1.321", "1.322", "1.323", "1.324", "1.325", "1.326", "1.327", "1.328", "1.329", "1.330", "1.331", "1.332", "1.333", "1.334", "1.335", "1.336",
+	"1.337", "1.338", "1.339", "1.340", "1.341", "1.342", "1.343", "1.344", "1.345", "1.346", "1.347", "1.348", "1.349", "1.350", "1.351", "1.352",
+	"1.353", "1.354", "1.355", "1.356", "1.357", "1.358", "1.359", "1.360", "1.361", "1.362", "1.363", "1.364", "1.365", "1.366", "1.367", "1.368",
+	"1.369", "1.370", "1.371", "1.372", "1.373", "1.374", "1.375", "1.376", "1.377", "1.378", "1.379", "1.380", "1.381", "1.382", "1.383", "1.384",
+	"1.385", "1.386", "1.387", "1.388", "1.389", "1.390", "1.391", "1.392", "1.393", "1.394", "1.395", "1.396", "1.397", "1.398", "1.399", "1.400",
+	"1.401", "1.402", "1.403", "1.404", "1.405", "1.406", "1.407", "1.408", "1.409", "1.410", "1.411", "1.412", "1.413", "1.414", "1.415", "1.416",
+	"1.417", "1.418", "1.419", "1.420", "1.421", "1.422", "1.423", "1.424", "1.425", "1.426", "1.427", "1.428", "1.429", "1.430", "1.431", "1.432",
+	"1.433", "1.434", "1.435", "1.436", "1.437", "1.438", "1.439", "1.440", "1.441", "1.442", "1.443", "1.444", "1.445", "1.446", "1.447", "1.448",
+	"1.449", "1.450", "1.451", "1.452", "1.453", "1.454", "1.455", "1.456", "1.457", "1.458", "1.459", "1.460", "1.461", "1.462", "1.463", "1.464",
+	"1.465", "1.466", "1.467", "1.468", "1.469", "1.470", "1.471", "1.472", "1.473", "1.474", "1.475", "1.476", "1.477", "1.478", "1.479", "1.480",
+	"1.481", "1.482", "1.483", "1.484", "1.485", "1.486", "1.487", "1.488", "1.489", "1.490", "1.491", "1.492", "1.493", "1.494", "1.495", "1.496",
+	"1.497", "1.498", "1.499", "1.500", "1.501", "1.502", "1.503", "1.504", "1.505", "1.506", "1.507", "1.508", "1.509", "1.510", "1.511", "1.512",
+	"1.513", "1.514", "1.515", "1.516", "1.517", "1.518", "1.519", "1.520", "1.521", "1.522", "1.523", "1.524", "1.525", "1.526", "1.527", "1.528",
+	"1.529", "1.530", "1.531", "1.532", "1.533", "1.534", "1.535", "1.536", "1.537", "1.538", "1.539", "1.540", "1.541", "1.542", "1.543", "1.544",
+	"1.545", "1.546", "1.547", "1.548", "1.549", "1.550", "1.551", "1.552", "1.553", "1.554", "1.555", "1.556", "1.557", "1.558", "1.559", "1.560",
+	"1.561", "1.562", "1.563", "1.564", "1.565", "1.566", "1.567", "1.568", "1.569", "1.570", "1.571", "1.572", "1.573", "1.574", "1.575", "1.576",
+	"1.577", "1.578", "1.579", "1.580", "1.581", "1.582", "1.583", "1.584", "1.585", "1.586", "1.587", "1.588", "1.589", "1.590", "1.591", "1.592",
+	"1.593", "1.594", "1.595", "1.596", "1.597", "1.598", "1.599", "1.600", "1.601", "1.602", "1.603", "1.604", "1.605", "1.606", "1.607", "1.608",
+	"1.609", "1.610", "1.611", "1.612", "1.613", "1.614", "1.615", "1.616", "1.617", "1.618", "1.619", "1.620", "1.621", "1.622", "1.623", "1.624",
+	"1.625", "1.626", "1.627", "1.628", "1.629", "1.630", "1.631", "1.632", "1.633", "1.634", "1.635", "1.636", "1.637", "1.638", "1.639", "1.640",
+	"1.641", "1.642", "1.643", "1.644", "1.645", "1.646", "1.647", "1.648", "1.649", "1.650", "1.651", "1.652", "1.653", "1.654", "1.655", "1.656",
+	"1.657", "1.658", "1.659", "1.660", "1.661", "1.662", "1.663", "1.664", "1.665", "1.666", "1.667", "1.668", "1.669", "1.670", "1.671", "1.672",
+	"1.673", "1.674", "1.675", "1.676", "1.677", "1.678", "1.679", "1.680", "1.681", "1.682", "1.683", "1.684", "1.685", "1.686", "1.687", "1.688",
+	"1.689", "1.690", "1.691", "1.692", "1.693", "1.694", "1.695", "1.696", "1.697", "1.698", "1.699", "1.700", "1.701", "1.702", "1.703", "1.704",
+	"1.705", "1.706", "1.707", "1.708", "1.709", "1.710", "1.711", "1.712", "1.713", "1.714", "1.715", "1.716", "1.717", "1.718", "1.719", "1.720",
+	"1.721", "1.722", "1.723", "1.724", "1.725", "1.726", "1.727", "1.728", "1.729", "1.730", "1.731", "1.732", "1.733", "1.734", "1.735", "1.736",
+	"1.737", "1.738", "1.739", "1.740", "1.741", "1.742", "1.743", "1.744", "1.745", "1.746", "1.747", "1.748", "1.749", "1.750", "1.751", "1.752",
+	"1.753", "1.754", "1.755", "1.756", "1.757", "1.758", "1.759", "1.760", "1.761", "1.762", "1.763", "1.764", "1.765", "1.766", "1.767", "1.768",
+	"1.769", "1.770", "1.771", "1.772", "1.773", "1.774", "1.775", "1.776", "1.777", "1.778", "1.779", "1.780", "1.781", "1.782", "1.783", "1.784",
+	"1.785", "1.786", "1.787", "1.788", "1.789", "1.790", "1.791", "1.792", "1.793", "1.794", "1.795", "1.796", "1.797", "1.798", "1.799", "1.800",
+	"1.801", "1.802", "1.803", "1.804", "1.805", "1.806", "1.807", "1.808", "1.809", "1.810", "1.811", "1.812", "1.813", "1.814", "1.815", "1.816",
+	"1.817", "1.818", "1.819", "1.820", "1.821", "1.822", "1.823", "1.824", "1.825", "1.826", "1.827", "1.828", "1.829", "1.830", "1.831", "1.832",
+	"1.833", "1.834", "1.835", "1.836", "1.837", "1.838", "1.839", "1.840", "1.841", "1.842", "1.843", "1.844", "1.845", "1.846", "1.847", "1.848",
+	"1.849", "1.850", "1.851", "1.852", "1.853", "1.854", "1.855", "1.856", "1.857", "1.858", "1.859", "1.860", "1.861", "1.862", "1.863", "1.864",
+	"1.865", "1.866", "1.867", "1.868", "1.869", "1.870", "1.871", "1.872", "1.873", "1.874", "1.875", "1.876", "1.877", "1.878", "1.879", "1.880",
+	"1.881", "1.882", "1.883", "1.884", "1.885", "1.886", "1.887", "1.888", "1.889", "1.890", "1.891", "1.892", "1.893", "1.894", "1.895", "1.896",
+	"1.897", "1.898", "1.899", "1.900", "1.901", "1.902", "1.903", "1.904", "1.905", "1.906", "1.907", "1.908", "1.909", "1.910", "1.911", "1.912",
+	"1.913", "1.914", "1.915", "1.916", "1.917", "1.918", "1.919", "1.920", "1.921", "1.922", "1.923", "1.924", "1.925", "1.926", "1.927", "1.928",
+	"1.929", "1.930", "1.931", "1.932", "1.933", "1.934", "1.935", "1.936", "1.937", "1.938", "1.939", "1.940", "1.941", "1.942", "1.943", "1.944",
+	"1.945", "1.946", "1.947", "1.948", "1.949", "1.950", "1.951", "1.952", "1.953", "1.954", "1.955", "1.956", "1.957", "1.958", "1.959", "1.960",
+	"1.961", "1.962", "1.963", "1.964", "1.965", "1.966", "1.967", "1.968", "1.969", "1.970", "1.971", "1.972", "1.973", "1.974", "1.975", "1.976",
+	"1.977", "1.978", "1.979", "1.980", "1.981", "1.982", "1.983", "1.984", "1.985", "1.986", "1.987", "1.988", "1.989", "1.990", "1.991", "1.992",
+	"1.993", "1.994", "1.995", "1.996", "1.997", "1.998", "1.999", "2.000", "2.001", "2.002", "2.003", "2.004", "2.005", "2.006", "2.007", "2.008",
+	"2.009", "2.010", "2.011", "2.012", "2.013", "2.014", "2.015", "2.016", "2.017", "2.018", "2.019", "2.020", "2.021", "2.022", "2.023", "2.024",
+	"2.025", "2.026", "2.027", "2.028", "2.029", "2.030", "2.031", "2.032", "2.033", "2.034", "2.035", "2.036", "2.037", "2.038", "2.039", "2.040",
+	"2.041", "2.042", "2.043", "2.044", "2.045", "2.046", "2.047", "2.048", "2.049", "2.050", "2.051", "2.052", "2.053", "2.054", "2.055", "2.056",
+	"2.057", "2.058", "2.059", "2.060", "2.061", "2.062", "2.063", "2.064", "2.065", "2.066", "2.067", "2.068", "2.069", "2.070", "2.071", "2.072",
+	"2.073", "2.074", "2.075", "2.076", "2.077", "2.078", "2.079", "2.080", "2.081", "2.082", "2.083", "2.084", "2.085", "2.086", "2.087", "2.088",
+	"2.089", "2.090", "2.091", "2.092", "2.093", "2.094", "2.095", "2.096", "2.097", "2.098", "2.099", "2.100", "2.101", "2.102", "2.103", "2.104",
+	"2.105", "2.106", "2.107", "2.108", "2.109", "2.110", "2.111", "2.112", "2.113", "2.114", "2.115", "2.116", "2.117", "2.118", "2.119", "2.120",
+	"2.121", "2.122", "2.123", "2.124", "2.125", "2.126", "2.127", "2.128", "2.129", "2.130", "2.131", "2.132", "2.133", "2.134", "2.135", "2.136",
+	"2.137", "2.138", "2.139", "2.140", "2.141", "2.142", "2.143", "2.144", "2.145", "2.146", "2.147", "2.148", "2.149", "2.150", "2.151", "2.152",
+	"2.153", "2.154", "2.155", "2.156", "2.157", "2.158", "2.159", "2.160", "2.161", "2.162", "2.163", "2.164", "2.165", "2.166", "2.167", "2.168",
+	"2.169", "2.170", "2.171", "2.172", "2.173", "2.174", "2.175", "2.176", "2.177", "2.178", "2.179", "2.180", "2.181", "2.182", "2.183", "2.184",
+	"2.185", "2.186", "2.187", "2.188", "2.189", "2.190", "2.191", "2.192", "2.193", "2.194", "2.195", "2.196", "2.197", "2.198", "2.199", "2.200",
+	"2.201", "2.202", "2.203", "2.204", "2.205", "2.206", "2.207", "2.208", "2.209", "2.210", "2.211", "2.212", "2.213", "2.214", "2.215", "2.216",
+	"2.217", "2.218", "2.219", "2.220", "2.221", "2.222", "2.223", "2.224", "2.225", "2.226", "2.227", "2.228", "2.229", "2.230", "2.231", "2.232",
+	"2.233", "2.234", "2.235", "2.236", "2.237", "2.238", "2.239", "2.240", "2.241", "2.242", "2.243", "2.244", "2.245", "2.246", "2.247", "2.248",
+	"2.249", "2.250", "2.251", "2.252", "2.253", "2.254", "2.255", "2.256", "2.257", "2.258", "2.259", "2.260", "2.261", "2.262", "2.263", "2.264",
+	"2.265", "2.266", "2.267", "2.268", "2.269", "2.270", "2.271", "2.272", "2.273", "2.274", "2.275", "2.276", "2.277", "2.278", "2.279", "2.280",
+	"2.281", "2.282", "2.283", "2.284", "2.285", "2.286", "2.287", "2.288", "2.289", "2.290", "2.291", "2.292", "2.293", "2.294", "2.295", "2.296",
+	"2.297", "2.298", "2.299", "2.300", "2.301", "2.302", "2.303", "2.304", "2.305", "2.306", "2.307", "2.308", "2.309", "2.310", "2.311", "2.312",
+	"2.313", "2.314", "2.315", "2.316", "2.317", "2.318", "2.319", "2.320", "2.321", "2.322", "2.323", "2.324", "2.325", "2.326", "2.327", "2.328",
+	"2.329", "2.330", "2.331", "2.332", "2.333", "2.334", "2.335", "2.336", "2.337", "2.338", "2.339", "2.340", "2.341", "2.342", "2.343", "2.344",
+	"2.345", "2.346", "2.347", "2.348", "2.349", "2.350", "2.351", "2.352", "2.353", "2.354", "2.355", "2.356", "2.357", "2.358", "2.359", "2.360",
+	"2.361", "2.362", "2.363", "2.364", "2.365", "2.366", "2.367", "2.368", "2.369", "2.370", "2.371", "2.372", "2.373", "2.374", "2.375", "2.376",
+	"2.377", "2.378", "2.379", "2.380", "2.381", "2.382", "2.383", "2.384", "2.385", "2.386", "2.387", "2.388", "2.389", "2.390", "2.391", "2.392",
+	"2.393", "2.394", "2.395", "2.396", "2.397", "2.398", "2.399", "2.400", "2.401", "2.402", "2.403", "2.404", "2.405", "2.406", "2.407", "2.408",
+	"2.409", "2.410", "2.411", "2.412", "2.413", "2.414", "2.415", "2.416", "2.417", "2.418", "2.419", "2.420", "2.421", "2.422", "2.423", "2.424",
+	"2.425", "2.426", "2.427", "2.428", "2.429", "2.430", "2.431", "2.432", "2.433", "2.434", "2.435", "2.436", "2.437", "2.438", "2.439", "2.440",
+	"2.441", "2.442", "2.443", "2.444", "2.445", "2.446", "2.447", "2.448", "2.449", "2.450", "2.451", "2.452", "2.453", "2.454", "2.455", "2.456",
+	"2.457", "2.458", "2.459", "2.460", "2.461", "2.462", "2.463", "2.464", "2.465", "2.466", "2.467", "2.468", "2.469", "2.470", "2.471", "2.472",
+	"2.473", "2.474", "2.475", "2.476", "2.477", "2.478", "2.479", "2.480", "2.481", "2.482", "2.483", "2.484", "2.485", "2.486", "2.487", "2.488",
+	"2.489", "2.490", "2.491", "2.492", "2.493", "2.494", "2.495", "2.496", "2.497", "2.498", "2.499", "2.500", "2.501", "2.502", "2.503", "2.504",
+	"2.505", "2.506", "2.507", "2.508", "2.509", "2.510", "2.511", "2.512", "2.513", "2.514", "2.515", "2.516", "2.517", "2.518", "2.519", "2.520",
+	"2.521", "2.522", "2.523", "2.524", "2.525", "2.526", "2.527", "2.528", "2.529", "2.530", "2.531", "2.532", "2.533", "2.534", "2.535", "2.536",
+	"2.537", "2.538", "2.539", "2.540", "2.541", "2.542", "2.543", "2.544", "2.545", "2.546", "2.547", "2.548", "2.549", "2.550", "2.551", "2.552",
+	"2.553", "2.554", "2.555", "2.556", "2.557", "2.558", "2.559", "2.560", "2.561", "2.562", "2.563", "2.564", "2.565", "2.566", "2.567", "2.568",
+	"2.569", "2.570", "2.571", "2.572", "2.573", "2.574", "2.575", "2.576", "2.577", "2.578", "2.579", "2.580", "2.581", "2.582", "2.583", "2.584",
+	"2.585", "2.586", "2.587", "2.588", "2.589", "2.590", "2.591", "2.592", "2.593", "2.594", "2.595", "2.596", "2.597", "2.598", "2.599", "2.600",
+	"2.601", "2.602", "2.603", "2.604", "2.605", "2.606", "2.607", "2.608", "2.609", "2.610", "2.611", "2.612", "2.613", "2.614", "2.615", "2.616",
+	"2.617", "2.618", "2.619", "2.620", "2.621", "2.622", "2.623", "2.624", "2.625", "2.626", "2.627", "2.628", "2.629", "2.630", "2.631", "2.632",
+	"2.633", "2.634", "2.635", "2.636", "2.637", "2.638", "2.639", "2.640", "2.641", "2.642", "2.643", "2.644", "2.645", "2.646", "2.647", "2.648",
+	"2.649", "2.650", "2.651", "2.652", "2.653", "2.654", "2.655", "2.656", "2.657", "2.658", "2.659", "2.660", "2.661", "2.662", "2.663", "2.664",
+	"2.665", "2.666", "2.667", "2.668", "2.669", "2.670", "2.671", "2.672", "2.673", "2.674", "2.675", "2.676", "2.677", "2.678", "2.679", "2.680",
+	"2.681", "2.682", "2.683", "2.684", "2.685", "2.686", "2.687", "2.688", "2.689", "2.690", "2.691", "2.692", "2.693", "2.694", "2.695", "2.696",
+	"2.697", "2.698", "2.699", "2.700", "2.701", "2.702", "2.703", "2.704", "2.705", "2.706", "2.707", "2.708", "2.709", "2.710", "2.711", "2.712",
+	"2.713", "2.714", "2.715", "2.716", "2.717", "2.718", "2.719", "2.720", "2.721", "2.722", "2.723", "2.724", "2.725", "2.726", "2.727", "2.728",
+	"2.729", "2.730", "2.731", "2.732", "2.733", "2.734", "2.735", "2.736", "2.737", "2.738", "2.739", "2.740", "2.741", "2.742", "2.743", "2.744",
+	"2.745", "2.746", "2.747", "2.748", "2.749", "2.750", "2.751", "2.752", "2.753", "2.754", "2.755", "2.756", "2.757", "2.758", "2.759", "2.760",
+	"2.761", "2.762", "2.763", "2.764", "2.765", "2.766", "2.767", "2.768", "2.769", "2.770", "2.771", "2.772", "2.773", "2.774", "2.775", "2.776",
+	"2.777", "2.778", "2.779", "2.780", "2.781", "2.782", "2.783", "2.784", "2.785", "2.786", "2.787", "2.788", "2.789", "2.790", "2.791", "2.792",
+	"2.793", "2.794", "2.795", "2.796", "2.797", "2.798", "2.799", "2.800", "2.801", "2.802", "2.803", "2.804", "2.805", "2.806", "2.807", "2.808",
+	"2.809", "2.810", "2.811", "2.812", "2.813", "2.814", "2.815", "2.816", "2.817", "2.818", "2.819", "2.820", "2.821", "2.822", "2.823", "2.824",
+	"2.825", "2.826", "2.827", "2.828", "2.829", "2.830", "2.831", "2.832", "2.833", "2.834", "2.835", "2.836", "2.837", "2.838", "2.839", "2.840",
+	"2.841", "2.842", "2.843", "2.844", "2.845", "2.846", "2.847", "2.848", "2.849", "2.850", "2.851", "2.852", "2.853", "2.854", "2.855", "2.856",
+	"2.857", "2.858", "2.859", "2.860", "2.861", "2.862", "2.863", "2.864", "2.865", "2.866", "2.867", "2.868", "2.869", "2.870", "2.871", "2.872",
+	"2.873", "2.874", "2.875", "2.876", "2.877", "2.878", "2.879", "2.880", "2.881", "2.882", "2.883", "2.884", "2.885", "2.886", "2.887", "2.888",
+	"2.889", "2.890", "2.891", "2.892", "2.893", "2.894", "2.895", "2.896", "2.897", "2.898", "2.899", "2.900", "2.901", "2.902", "2.903", "2.904",
+	"2.905", "2.906", "2.907", "2.908", "2.909", "2.910", "2.911", "2.912", "2.913", "2.914", "2.915", "2.916", "2.917", "2.918", "2.919", "2.920",
+	"2.921", "2.922", "2.923", "2.924", "2.925", "2.926", "2.927", "2.928", "2.929", "2.930", "2.931", "2.932", "2.933", "2.934", "2.935", "2.936",
+	"2.937", "2.938", "2.939", "2.940", "2.941", "2.942", "2.943", "2.944", "2.945", "2.946", "2.947", "2.948", "2.949", "2.950", "2.951", "2.952",
+	"2.953", "2.954", "2.955", "2.956", "2.957", "2.958", "2.959", "2.960", "2.961", "2.962", "2.963", "2.964", "2.965", "2.966", "2.967", "2.968",
+	"2.969", "2.970", "2.971", "2.972", "2.973", "2.974", "2.975", "2.976", "2.977", "2.978", "2.979", "2.980", "2.981", "2.982", "2.983", "2.984",
+	"2.985", "2.986", "2.987", "2.988", "2.989", "2.990", "2.991", "2.992", "2.993", "2.994", "2.995", "2.996", "2.997", "2.998", "2.999", "1c1",
+	"2c1", "5c1", "10c1", "11c1", "21c1", "100c1", "1.5c1", "1.0000001c1", "1c2", "2c2", "5c2", "10c2", "11c2", "21c2", "100c2", "1.5c2",
+	"1.0000001c2", "1c3", "2c3", "5c3", "10c3", "11c3", "21c3", "100c3", "1.5c3", "1.0000001c3", "1c4", "2c4", "5c4", "10c4", "11c4", "21c4",
+	"100c4", "1.5c4", "1.0000001c4", "1c5", "2c5", "5c5", "10c5", "11c5", "21c5", "100c5", "1.5c5", "1.0000001c5", "1c6", "2c6", "5c6", "10c6",
+	"11c6", "21c6", "100c6", "1.5c6", "1.0000001c6", "1c7", "2c7", "5c7", "10c7", "11c7", "21c7", "100c7", "1.5c7", "1.0000001c7", "1c8", "2c8",
+	"5c8", "10c8", "11c8", "21c8", "100c8", "1.5c8", "1.0000001c8", "1c9", "2c9", "5c9", "10c9", "11c9", "21c9", "100c9", "1.5c9", "1.0000001c9",
+}
+
 func TestPluralFunc_af(t *testing.T) {
 	fn := getPluralFunc(t, "af")
 	if nil != fn {
@@ -0,0 +1,316 @@
+package plural
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Matched by any *UnknownLocaleError, see errors.Is
+var ErrUnknownLocale = errors.New("UnknownLocale")
+
+// Maximum number of cultures suggested by an UnknownLocaleError
+const max_suggestions = 5
+
+// Returned when no rules apply to a locale, nor to any of its parents
+type UnknownLocaleError struct {
+	// Locale as requested
+	Locale string
+	// Known cultures the locale may have been mistaken for, closest first
+	Suggestions []string
+}
+
+func (e *UnknownLocaleError) Error() string {
+	if 0 == len(e.Suggestions) {
+		return fmt.Sprintf("UnknownCulture: `%s`", e.Locale)
+	}
+	return fmt.Sprintf("UnknownCulture: `%s` (did you mean `%s`?)", e.Locale, strings.Join(e.Suggestions, "`, `"))
+}
+
+func (e *UnknownLocaleError) Is(target error) bool {
+	return ErrUnknownLocale == target
+}
+
+// Returned (wrapped) by Ordinal when CLDR defines no ordinal rules for a
+// culture nor for its parents: its Func then returns NoOrdinal for ordinal
+// values
+var ErrNoOrdinal = errors.New("NoOrdinalRules")
+
+// Returns the cardinal plural function of a culture
+func Cardinal(locale string) (func(interface{}) Category, error) {
+	fn, err := GetCategoryFunc(locale)
+	if nil != err {
+		return nil, err
+	}
+	return func(value interface{}) Category {
+		return fn(value, false)
+	}, nil
+}
+
+// Returns the ordinal plural function of a culture, or an error matching
+// ErrNoOrdinal (see errors.Is) if neither it nor its parents have ordinal
+// rules
+func Ordinal(locale string) (func(interface{}) Category, error) {
+	culture, err := resolveOrdinal(locale)
+	if nil != err {
+		return nil, err
+	}
+	fn := plural_funcs[culture]
+	return func(value interface{}) Category {
+		// Values which are not numbers (see NewOperands) are handled as 0
+		ops, _ := NewOperands(value)
+		return fn(ops, true)
+	}, nil
+}
+
+// Checks whether CLDR defines ordinal rules for a culture, or for any of its
+// parents
+func HasOrdinal(locale string) bool {
+	_, err := resolveOrdinal(locale)
+	return nil == err
+}
+
+// Returns the culture whose ordinal rules apply to a locale: as with Resolve,
+// but the parents of a culture without ordinal rules are searched for some,
+// eg. pt-AO => pt-PT (which has none) => pt
+func resolveOrdinal(locale string) (string, error) {
+	if _, err := Resolve(locale); nil != err {
+		return "", err
+	}
+
+	culture, ok := lookup(locale, func(culture string) bool {
+		return plural_ordinals[culture]
+	})
+	if !ok {
+		return "", fmt.Errorf("%w: `%s`", ErrNoOrdinal, locale)
+	}
+	return culture, nil
+}
+
+// Returns the plural category of a value for a locale (see Resolve), unlike
+// a Func which handles anything but a number as 0: errors are either an
+// *UnknownLocaleError, an error matching ErrNoOrdinal, or a *NumberError
+// telling why (and where) the value is not a number. NaN and infinities are
+// rejected.
+func Select(locale string, value interface{}, ordinal bool) (Category, error) {
+	var culture string
+	var err error
+	if ordinal {
+		culture, err = resolveOrdinal(locale)
+	} else {
+		culture, err = Resolve(locale)
+	}
+	if nil != err {
+		return Other, err
+	}
+
+	ops, err := NewOperands(value)
+	if nil != err {
+		return Other, err
+	}
+	return plural_funcs[culture](ops, ordinal), nil
+}
+
+// Returns the plural category of a range, eg. "1–3 days", for a locale (see
+// Resolve): the one CLDR gives for the cardinal categories of its start and
+// end (for the locale or its closest parent having such data), or the
+// category of its end when CLDR has none. Errors are the ones of Select.
+func SelectRange(locale string, start, end interface{}) (Category, error) {
+	fn, err := GetOperandsFunc(locale)
+	if nil != err {
+		return Other, err
+	}
+
+	start_ops, err := NewOperands(start)
+	if nil != err {
+		return Other, err
+	}
+	end_ops, err := NewOperands(end)
+	if nil != err {
+		return Other, err
+	}
+
+	culture, _ := lookup(locale, func(culture string) bool {
+		_, ok := plural_ranges[culture]
+		return ok
+	})
+	start_category, end_category := fn(start_ops, false), fn(end_ops, false)
+	if result, ok := plural_ranges[culture][[2]Category{start_category, end_category}]; ok {
+		return result, nil
+	}
+	return end_category, nil
+}
+
+// Returns the culture whose rules apply to a locale: the locale itself when
+// known, else the first known one among its explicit CLDR parents and its
+// truncated tags, eg. pt-AO => pt-PT => pt or zh-Hant-HK => zh-Hant => zh.
+// The locale is first canonicalized, eg. "EN_us" => "en-US", "iw" => "he".
+func Resolve(locale string) (string, error) {
+	culture, ok := lookup(locale, func(culture string) bool {
+		_, ok := plural_funcs[culture]
+		return ok
+	})
+	if !ok {
+		return "", &UnknownLocaleError{locale, suggest(canonical(locale))}
+	}
+	return culture, nil
+}
+
+// Returns the first culture, among a (canonicalized) locale and the ones it
+// falls back to (see Resolve), for which `found` holds
+func lookup(locale string, found func(string) bool) (string, bool) {
+	culture := canonical(locale)
+	for {
+		if found(culture) {
+			return culture, true
+		}
+
+		if parent, ok := plural_parents[culture]; ok {
+			culture = parent
+			continue
+		}
+
+		pos := strings.LastIndex(culture, "-")
+		if -1 == pos {
+			return "", false
+		}
+		culture = culture[:pos]
+
+		// A single letter subtag introduces an extension, eg. "de-u-co-phonebk"
+		if pos := strings.LastIndex(culture, "-"); -1 != pos && pos == len(culture)-2 {
+			culture = culture[:pos]
+		}
+	}
+}
+
+// Returns a locale with "-" separated subtags, a lowercase language, a
+// titlecase script and an uppercase region, without its extensions
+// ("-u-nu-latn", "-x-private"...) and with its deprecated language codes
+// replaced, eg. "EN_us" => "en-US", "sh-RS-u-nu-latn" => "sr-Latn-RS"
+func canonical(locale string) string {
+	subtags := strings.Split(strings.ToLower(strings.Replace(locale, "_", "-", -1)), "-")
+	for i := 1; i < len(subtags); i++ {
+		if 1 == len(subtags[i]) {
+			subtags = subtags[:i]
+			break
+		}
+	}
+
+	// Aliases of whole tags come first, eg. "zh-min-nan" => "nan"
+	if replacement, ok := plural_aliases[strings.Join(subtags, "-")]; ok {
+		subtags = strings.Split(strings.ToLower(replacement), "-")
+	}
+
+	language, script, region, variants := splitTag(subtags)
+	if replacement, ok := plural_aliases[language]; ok {
+		// The script and region of the locale take precedence over the ones of the replacement
+		var alias_script, alias_region string
+		language, alias_script, alias_region, _ = splitTag(strings.Split(strings.ToLower(replacement), "-"))
+		if "" == script {
+			script = alias_script
+		}
+		if "" == region {
+			region = alias_region
+		}
+	}
+
+	result := language
+	if "" != script {
+		result += "-" + strings.ToUpper(script[:1]) + script[1:]
+	}
+	if "" != region {
+		result += "-" + strings.ToUpper(region)
+	}
+	for _, variant := range variants {
+		result += "-" + variant
+	}
+	return result
+}
+
+// Splits the lowercase subtags of a locale into its language, script, region and variants
+func splitTag(subtags []string) (string, string, string, []string) {
+	var script, region string
+
+	rest := subtags[1:]
+	if len(rest) > 0 && 4 == len(rest[0]) && isLetters(rest[0]) {
+		script, rest = rest[0], rest[1:]
+	}
+	if len(rest) > 0 && ((2 == len(rest[0]) && isLetters(rest[0])) || (3 == len(rest[0]) && !isLetters(rest[0]))) {
+		region, rest = rest[0], rest[1:]
+	}
+	return subtags[0], script, region, rest
+}
+
+func isLetters(subtag string) bool {
+	for _, char := range subtag {
+		if char < 'a' || char > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the known cultures sharing the language of a (canonical) locale,
+// then the ones whose language is a typo away from it
+func suggest(locale string) []string {
+	language := strings.SplitN(locale, "-", 2)[0]
+
+	max_distance := 1
+	if len(language) > 3 {
+		max_distance = 2
+	}
+
+	type suggestion struct {
+		culture  string
+		distance int
+	}
+
+	var suggestions []suggestion
+	for culture := range plural_funcs {
+		distance := editDistance(language, strings.SplitN(culture, "-", 2)[0])
+		if distance <= max_distance {
+			suggestions = append(suggestions, suggestion{culture, distance})
+		}
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].culture < suggestions[j].culture
+	})
+
+	var result []string
+	for i := 0; i < len(suggestions) && i < max_suggestions; i++ {
+		result = append(result, suggestions[i].culture)
+	}
+	return result
+}
+
+// Levenshtein distance between two (ASCII) strings
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			// Deletion, insertion or substitution
+			current[j] = previous[j] + 1
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+			if a[i-1] == b[j-1] && previous[j-1] < current[j] {
+				current[j] = previous[j-1]
+			} else if previous[j-1]+1 < current[j] {
+				current[j] = previous[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,427 @@
+package plural
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Whether the package was generated with the rules of every given culture,
+// which it may lack when generated with -culture
+func hasCultures(cultures ...string) bool {
+	for _, culture := range cultures {
+		if _, ok := plural_funcs[culture]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Skips a test written for cultures the package was generated without
+func skipWithout(test testing.TB, cultures ...string) {
+	if !hasCultures(cultures...) {
+		test.Skipf("Generated without the rules of `%s`", strings.Join(cultures, "`, `"))
+	}
+}
+
+// Language of a locale, eg. "pt" for "pt-AO"
+func language(locale string) string {
+	return strings.SplitN(locale, "-", 2)[0]
+}
+
+// Parent locales of CLDR the tests rely on
+var cldr_parents = map[string]string{"pt-AO": "pt-PT"}
+
+// Language aliases of CLDR the tests rely on
+var cldr_aliases = map[string]string{"in": "id", "iw": "he", "no": "nb", "sh": "sr-Latn", "tl": "fil", "deu": "de"}
+
+// Adds some entries to a table generated from optional CLDR data, which the
+// package may have been generated without, and returns the function restoring
+// it, eg. defer withEntries(&plural_parents, cldr_parents)()
+func withEntries(table *map[string]string, entries map[string]string) func() {
+	saved := *table
+	result := make(map[string]string, len(saved)+len(entries))
+	for key, value := range saved {
+		result[key] = value
+	}
+	for key, value := range entries {
+		result[key] = value
+	}
+	*table = result
+	return func() { *table = saved }
+}
+
+func TestCardinal(t *testing.T) {
+	skipWithout(t, "be")
+	fn, err := Cardinal("be")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(2); Few != result {
+		t.Errorf("be `2` : expected `few` but got `%s`", result)
+	}
+
+	if _, err := Cardinal("xx"); nil == err {
+		t.Errorf("`xx` : expected an error")
+	}
+}
+
+// The samples the package was generated from lost the ranges of CLDR (eg.
+// "2~17" only gives 2 and 17): checks the values in between for Portuguese
+func TestPortugueseSpans(t *testing.T) {
+	skipWithout(t, "pt", "pt-PT")
+	for _, item := range []struct {
+		locale  string
+		ordinal bool
+		from    int
+		to      int
+		scale   float64
+		one     []float64
+	}{
+		{"pt", false, 0, 17, 1, []float64{0, 1}},
+		{"pt", false, 0, 17, 10, []float64{0, 1}},
+		{"pt", true, 0, 15, 1, nil},
+		{"pt-PT", false, 0, 16, 1, []float64{1}},
+		{"pt-PT", false, 0, 15, 10, nil},
+	} {
+		fn, err := GetCategoryFunc(item.locale)
+		if nil != err {
+			t.Fatal(err)
+		}
+		for i := item.from; i <= item.to; i++ {
+			value := float64(i) / item.scale
+			expected := Other
+			for _, one := range item.one {
+				if one == value {
+					expected = One
+				}
+			}
+
+			var input interface{} = i
+			if 1 != item.scale {
+				input = strconv.FormatFloat(value, 'f', 1, 64)
+			}
+			if result := fn(input, item.ordinal); expected != result {
+				t.Errorf("%s `%v` (ordinal: %v) : expected `%s` but got `%s`", item.locale, input, item.ordinal, expected, result)
+			}
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	skipWithout(t, "en", "be")
+	fn, err := Ordinal("en")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(2); Two != result {
+		t.Errorf("en `2` : expected `two` but got `%s`", result)
+	}
+
+	// CLDR has no ordinal rules for Belarusian
+	fn, err = Ordinal("be")
+	if nil != fn || !errors.Is(err, ErrNoOrdinal) {
+		t.Errorf("be : expected ErrNoOrdinal but got %v", err)
+	}
+
+	if _, err := Ordinal("xx"); !errors.Is(err, ErrUnknownLocale) || errors.Is(err, ErrNoOrdinal) {
+		t.Errorf("`xx` : expected an unknown culture error but got %v", err)
+	}
+}
+
+func TestInheritedOrdinal(t *testing.T) {
+	skipWithout(t, "pt", "pt-PT")
+	defer withEntries(&plural_parents, cldr_parents)()
+	// pt-AO follows the cardinal rules of pt-PT, which has no ordinal rules,
+	// and the ordinal rules of pt
+	fn, err := Ordinal("pt-AO")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(1); Other != result {
+		t.Errorf("pt-AO `1` : expected `other` but got `%s`", result)
+	}
+
+	if result, err := Select("pt-AO", 1, true); nil != err || Other != result {
+		t.Errorf("pt-AO `1` (ordinal) : expected `other` but got `%s` (%v)", result, err)
+	}
+	if result, err := Select("pt-AO", 1, false); nil != err || One != result {
+		t.Errorf("pt-AO `1` : expected `one` but got `%s` (%v)", result, err)
+	}
+
+	named := testFunc(t, "pt-AO")
+	if result := named(1, true); "other" != result {
+		t.Errorf("pt-AO `1` (ordinal) : expected `other` but got `%s`", result)
+	}
+	if result := named(0, false); "other" != result {
+		t.Errorf("pt-AO `0` : expected `other` but got `%s`", result)
+	}
+}
+
+func TestHasOrdinal(t *testing.T) {
+	for locale, expected := range map[string]bool{"en": true, "fr": true, "af": true, "pt-AO": true, "pt-PT": true, "be": false, "ak": false, "xx": false} {
+		if expected && !hasCultures(language(locale)) {
+			continue
+		}
+		if result := HasOrdinal(locale); expected != result {
+			t.Errorf("`%s` : expected %v but got %v", locale, expected, result)
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	skipWithout(t, "ru", "en", "be")
+	if result, err := Select("ru", "21", false); nil != err || One != result {
+		t.Errorf("ru `21` : expected `one` but got `%s` (%v)", result, err)
+	}
+	if result, err := Select("en", 3, true); nil != err || Few != result {
+		t.Errorf("en `3` : expected `few` but got `%s` (%v)", result, err)
+	}
+
+	for _, value := range []interface{}{"abc", "1,5", math.NaN(), math.Inf(1), math.Inf(-1), nil} {
+		if result, err := Select("en", value, false); !errors.Is(err, ErrInvalidNumber) {
+			t.Errorf("en `%v` : expected ErrInvalidNumber but got `%s` (%v)", value, result, err)
+		}
+	}
+
+	if _, err := Select("be", 2, true); !errors.Is(err, ErrNoOrdinal) {
+		t.Errorf("be : expected ErrNoOrdinal but got %v", err)
+	}
+	if _, err := Select("xx", 2, false); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("`xx` : expected ErrUnknownLocale but got %v", err)
+	}
+
+	// A Func stays lenient
+	if result := testFunc(t, "en")("1,5", false); "other" != result {
+		t.Errorf("en `1,5` : expected `other` but got `%s`", result)
+	}
+}
+
+func TestSelectRange(t *testing.T) {
+	for _, item := range []struct {
+		locale     string
+		start, end interface{}
+		expected   Category
+	}{
+		{"en", 1, 3, Other},
+		{"fr", 0, 1, One},
+		{"fr", "0.5", 1, One},
+		{"ru", 1, 21, One},
+		{"ru", 1, 2, Few},
+		{"pt-AO", 0, 1, One},
+		{"sw", 0, 1, One},
+	} {
+		if !hasCultures(language(item.locale)) {
+			continue
+		}
+		if result, err := SelectRange(item.locale, item.start, item.end); nil != err || item.expected != result {
+			t.Errorf("%s `%v~%v` : expected `%s` but got `%s` (%v)", item.locale, item.start, item.end, item.expected, result, err)
+		}
+	}
+
+	if hasCultures("pt", "pt-PT") {
+		defer withEntries(&plural_parents, cldr_parents)()
+		// Ranges of pt apply to pt-AO, though its cardinal rules are the ones of pt-PT
+		ranges, ok := plural_ranges["pt"]
+		plural_ranges["pt"] = map[[2]Category]Category{{Other, One}: Other}
+		result, err := SelectRange("pt-AO", 0, 1)
+		if ok {
+			plural_ranges["pt"] = ranges
+		} else {
+			delete(plural_ranges, "pt")
+		}
+		if nil != err || Other != result {
+			t.Errorf("pt-AO `0~1` : expected the range of pt `other` but got `%s` (%v)", result, err)
+		}
+	}
+
+	if hasCultures("en") {
+		if _, err := SelectRange("en", 1, "1,5"); !errors.Is(err, ErrInvalidNumber) {
+			t.Errorf("en `1~1,5` : expected ErrInvalidNumber but got %v", err)
+		}
+	}
+	if _, err := SelectRange("xx", 1, 2); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("`xx` : expected ErrUnknownLocale but got %v", err)
+	}
+}
+
+func testFunc(test *testing.T, culture string) func(interface{}, bool) string {
+	fn, err := GetFunc(culture)
+	if nil != err {
+		test.Fatal(err)
+	}
+	return fn
+}
+
+func TestResolve(t *testing.T) {
+	defer withEntries(&plural_parents, cldr_parents)()
+	for locale, expected := range map[string]string{
+		"fr":                 "fr",
+		"en-US":              "en",
+		"en-GB":              "en",
+		"pt_BR":              "pt",
+		"pt-PT":              "pt-PT",
+		"pt-AO":              "pt-PT",
+		"zh-Hant-HK":         "zh",
+		"sr-Latn-RS":         "sr",
+		"de-CH-u-co-phonebk": "de",
+		"es-419":             "es",
+	} {
+		if !hasCultures(expected) {
+			continue
+		}
+		if result, err := Resolve(locale); nil != err {
+			t.Errorf("`%s` : unexpected error %s", locale, err)
+		} else if expected != result {
+			t.Errorf("`%s` : expected `%s` but got `%s`", locale, expected, result)
+		}
+	}
+
+	for _, locale := range []string{"xx", "xx-FR", "", "-"} {
+		if _, err := Resolve(locale); nil == err {
+			t.Errorf("`%s` : expected an error", locale)
+		}
+	}
+}
+
+func TestFallback(t *testing.T) {
+	skipWithout(t, "pt", "pt-PT", "en")
+	defer withEntries(&plural_parents, cldr_parents)()
+	// pt-AO follows the rules of pt-PT, not the Brazilian ones
+	fn, err := Cardinal("pt-AO")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(0); Other != result {
+		t.Errorf("pt-AO `0` : expected `other` but got `%s`", result)
+	}
+
+	fn, err = Cardinal("pt_BR")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(0); One != result {
+		t.Errorf("pt_BR `0` : expected `one` but got `%s`", result)
+	}
+
+	if !HasOrdinal("en-US") {
+		t.Errorf("en-US : expected ordinal rules")
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	defer withEntries(&plural_aliases, cldr_aliases)()
+	for locale, expected := range map[string]string{
+		"en":                 "en",
+		"EN_us":              "en-US",
+		"zh_hant_tw":         "zh-Hant-TW",
+		"es-419":             "es-419",
+		"de-CH-u-co-phonebk": "de-CH",
+		"en-US-x-twain":      "en-US",
+		"en-us-posix":        "en-US-posix",
+		"iw":                 "he",
+		"iw-IL":              "he-IL",
+		"in":                 "id",
+		"tl":                 "fil",
+		"no":                 "nb",
+		"sh":                 "sr-Latn",
+		"sh_rs":              "sr-Latn-RS",
+		"sh-Cyrl":            "sr-Cyrl",
+		"deu-AT":             "de-AT",
+	} {
+		if result := canonical(locale); expected != result {
+			t.Errorf("`%s` : expected `%s` but got `%s`", locale, expected, result)
+		}
+	}
+}
+
+func TestResolveAliases(t *testing.T) {
+	defer withEntries(&plural_aliases, cldr_aliases)()
+	for locale, expected := range map[string]string{
+		"EN_us":              "en",
+		"iw":                 "he",
+		"in_ID":              "id",
+		"tl-PH":              "fil",
+		"no":                 "nb",
+		"sh":                 "sr",
+		"fr-FR-u-ca-gregory": "fr",
+	} {
+		if !hasCultures(expected) {
+			continue
+		}
+		if result, err := Resolve(locale); nil != err {
+			t.Errorf("`%s` : unexpected error %s", locale, err)
+		} else if expected != result {
+			t.Errorf("`%s` : expected `%s` but got `%s`", locale, expected, result)
+		}
+	}
+
+	// The plural functions are looked up with the canonical locale
+	skipWithout(t, "he")
+	fn, err := GetFunc("IW_il")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(2, false); "two" != result {
+		t.Errorf("IW_il `2` : expected `two` but got `%s`", result)
+	}
+}
+
+func TestUnknownLocaleError(t *testing.T) {
+	skipWithout(t, "fr", "fur")
+	_, err := GetFunc("frr")
+
+	var unknown *UnknownLocaleError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("`frr` : expected an UnknownLocaleError but got %v", err)
+	}
+	if !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("`frr` : expected ErrUnknownLocale to match")
+	}
+	if "frr" != unknown.Locale {
+		t.Errorf("expected `frr` but got `%s`", unknown.Locale)
+	}
+	if 2 != len(unknown.Suggestions) || "fr" != unknown.Suggestions[0] || "fur" != unknown.Suggestions[1] {
+		t.Errorf("`frr` : unexpected suggestions %v", unknown.Suggestions)
+	}
+	if expected := "UnknownCulture: `frr` (did you mean `fr`, `fur`?)"; expected != err.Error() {
+		t.Errorf("expected %s but got %s", expected, err)
+	}
+
+	_, err = Cardinal("portugese")
+	if !errors.As(err, &unknown) || 0 != len(unknown.Suggestions) || "UnknownCulture: `portugese`" != err.Error() {
+		t.Errorf("`portugese` : unexpected error %v", err)
+	}
+}
+
+func TestSuggestSameLanguage(t *testing.T) {
+	skipWithout(t, "pt-PT")
+
+	// As if the package had been generated with -culture=pt-PT
+	if fn, ok := plural_funcs["pt"]; ok {
+		delete(plural_funcs, "pt")
+		defer func() {
+			plural_funcs["pt"] = fn
+		}()
+	}
+
+	_, err := Resolve("pt-BR")
+
+	var unknown *UnknownLocaleError
+	if !errors.As(err, &unknown) || 0 == len(unknown.Suggestions) || "pt-PT" != unknown.Suggestions[0] {
+		t.Errorf("`pt-BR` : expected `pt-PT` to be suggested but got %v", err)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, data := range []struct {
+		a, b     string
+		expected int
+	}{{"", "", 0}, {"en", "en", 0}, {"en", "", 2}, {"fr", "frr", 1}, {"fur", "fr", 1}, {"kitten", "sitting", 3}} {
+		if result := editDistance(data.a, data.b); data.expected != result {
+			t.Errorf("`%s`, `%s` : expected %d but got %d", data.a, data.b, data.expected, result)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+//go:build go1.18
+// +build go1.18
+
+package plural
+
+// Types accepted by the generic API, including the ones defined upon them
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Returns the cardinal category of a number for a locale (see Resolve), eg.
+// CardinalOf("ru", uint16(21)) is One
+func CardinalOf[T Number](locale string, value T) (Category, error) {
+	return Select(locale, value, false)
+}
+
+// Returns the ordinal category of a number for a locale (see Resolve), or an
+// error matching ErrNoOrdinal if the locale has no ordinal rules
+func OrdinalOf[T Number](locale string, value T) (Category, error) {
+	return Select(locale, value, true)
+}
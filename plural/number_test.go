@@ -0,0 +1,44 @@
+//go:build go1.18
+// +build go1.18
+
+package plural
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCardinalOf(t *testing.T) {
+	skipWithout(t, "ru", "pt", "fr", "en")
+	if result, err := CardinalOf("ru", uint16(21)); nil != err || One != result {
+		t.Errorf("ru `21` : expected `one` but got `%s` (%v)", result, err)
+	}
+	if result, err := CardinalOf("pt_BR", 0.0); nil != err || One != result {
+		t.Errorf("pt_BR `0.0` : expected `one` but got `%s` (%v)", result, err)
+	}
+	if result, err := CardinalOf("fr", count(2)); nil != err || Other != result {
+		t.Errorf("fr `2` : expected `other` but got `%s` (%v)", result, err)
+	}
+
+	if _, err := CardinalOf("xx", 1); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("`xx` : expected ErrUnknownLocale but got %v", err)
+	}
+	if _, err := CardinalOf("en", math.NaN()); nil == err {
+		t.Errorf("en `NaN` : expected an error")
+	}
+}
+
+func TestOrdinalOf(t *testing.T) {
+	skipWithout(t, "en", "be")
+	if result, err := OrdinalOf("en-GB", int64(23)); nil != err || Few != result {
+		t.Errorf("en-GB `23` : expected `few` but got `%s` (%v)", result, err)
+	}
+
+	if _, err := OrdinalOf("be", 2); !errors.Is(err, ErrNoOrdinal) {
+		t.Errorf("be : expected ErrNoOrdinal but got %v", err)
+	}
+	if _, err := OrdinalOf("xx", 2); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("`xx` : expected ErrUnknownLocale but got %v", err)
+	}
+}
@@ -0,0 +1,415 @@
+package plural
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Operands of a number, see http://unicode.org/reports/tr35/tr35-numbers.html#Operands
+//
+// Integers greater than 10^18 (whether I, F or T) are kept modulo 10^18, plus
+// 10^18: they still give the remainders of their division by any power of 10,
+// which is all the plural rules need, but never equal a value of a rule.
+type Operands struct {
+	// Absolute value of the number, approximated: rules rely on I and T instead
+	N float64
+	// Integer digits of N
+	I uint64
+	// Number of visible fraction digits, with and without trailing zeros
+	V, W int
+	// Visible fraction digits, with and without trailing zeros
+	F, T uint64
+	// Exponent of the power of 10 used in compact decimal notation, eg. 6 for "1.2c6"
+	E int
+	// Whether the number is negative: rules only apply to its absolute value
+	Negative bool
+}
+
+// A number in compact decimal notation, given as its mantissa (an int, an
+// int64, a float64 or a string) and its exponent, eg. Compact{1.2, 6} for
+// "1.2c6", displayed as "1.2M"
+type Compact struct {
+	Mantissa interface{}
+	Exponent int
+}
+
+// Matched by any *NumberError, see errors.Is
+var ErrInvalidNumber = errors.New("InvalidNumber")
+
+// Returned for values which are not numbers
+type NumberError struct {
+	// Value as given, formatted when it is not a string
+	Input string
+	// Byte offset in Input of the first invalid character, -1 if irrelevant
+	Position int
+	// Why the value is not a number, eg. "unexpected character `,`"
+	Reason string
+}
+
+func (e *NumberError) Error() string {
+	if e.Position < 0 {
+		return fmt.Sprintf("InvalidNumber: `%s` (%s)", e.Input, e.Reason)
+	}
+	return fmt.Sprintf("InvalidNumber: `%s` (%s at position %d)", e.Input, e.Reason, e.Position)
+}
+
+func (e *NumberError) Is(target error) bool {
+	return ErrInvalidNumber == target
+}
+
+func invalidValue(value interface{}, reason string) error {
+	return &NumberError{fmt.Sprintf("%v", value), -1, reason}
+}
+
+func unsupportedType(value interface{}) error {
+	return invalidValue(value, fmt.Sprintf("unsupported type `%T`", value))
+}
+
+// Value of `n` when it has a fraction: it then never equals an integer nor
+// lies in a range of integers
+const fractional = math.MaxUint64
+
+// Integers greater than this one are reduced, see Operands
+const overflow = 1000000000000000000
+
+// Returns the operands of a number, given as any integer or float type, a
+// *big.Int, a *big.Float, a *big.Rat, a string (see ParseOperands), a
+// json.Number (in scientific notation: "1.5e3" is 1500, its `e` operand being
+// 0), a fmt.Stringer writing a number, or a Compact. Floats have no visible
+// trailing zeros: 1.50 is read as "1.5", use a string to keep them.
+func NewOperands(value interface{}) (Operands, error) {
+	switch value.(type) {
+	case Operands:
+		return value.(Operands), nil
+
+	case Compact:
+		return compactOperands(value.(Compact))
+
+	case int:
+		return integerOperands(int64(value.(int))), nil
+
+	case int64:
+		return integerOperands(value.(int64)), nil
+	}
+
+	strval, err := decimalString(value)
+	if nil != err {
+		return Operands{}, err
+	}
+	return ParseOperands(strval)
+}
+
+// Returns the operands of a number written in decimal, eg. "-1.50", "+3" or
+// " 1.2c6 " (compact decimal notation, "1.2e6" being a synonym). Errors are
+// *NumberError giving the position of the first invalid character.
+func ParseOperands(value string) (Operands, error) {
+	strval := strings.TrimSpace(value)
+	// Position of `strval` in `value`
+	offset := strings.Index(value, strval)
+
+	fail := func(pos int, reason string) (Operands, error) {
+		return Operands{}, &NumberError{value, offset + pos, reason}
+	}
+
+	unexpected := func(pos int) (Operands, error) {
+		char, _ := utf8.DecodeRuneInString(strval[pos:])
+		return fail(pos, fmt.Sprintf("unexpected character `%c`", char))
+	}
+
+	if "" == strval {
+		return Operands{}, &NumberError{value, -1, "empty value"}
+	}
+
+	pos := 0
+	negative := '-' == strval[0]
+	if negative || '+' == strval[0] {
+		pos++
+	}
+
+	// Unsigned number, checked below
+	mantissa := strval[pos:]
+
+	start := pos
+	pos = skipDigits(strval, pos)
+	if start == pos {
+		if pos < len(strval) && '.' != strval[pos] {
+			return unexpected(pos)
+		}
+		return fail(pos, "missing integer digits")
+	}
+
+	if pos < len(strval) && '.' == strval[pos] {
+		pos++
+		start = pos
+		pos = skipDigits(strval, pos)
+		if start == pos {
+			if pos < len(strval) {
+				return unexpected(pos)
+			}
+			return fail(pos, "missing fraction digits")
+		}
+	}
+
+	if pos < len(strval) && -1 != strings.IndexByte("ceCE", strval[pos]) {
+		pos++
+		start = pos
+		pos = skipDigits(strval, pos)
+		if start == pos {
+			if pos < len(strval) {
+				return unexpected(pos)
+			}
+			return fail(pos, "missing exponent digits")
+		}
+
+		exponent, err := strconv.Atoi(strval[start:pos])
+		if nil != err || exponent > max_exponent {
+			return fail(start, fmt.Sprintf("exponent greater than %d", max_exponent))
+		}
+	}
+
+	if pos < len(strval) {
+		return unexpected(pos)
+	}
+
+	// Numbers in compact decimal notation are expanded, eg. "1.2c6" => "1200000"
+	mantissa, e := compact(mantissa)
+
+	strint, strf := mantissa, ""
+	if dot := strings.Index(mantissa, "."); -1 != dot {
+		strint, strf = mantissa[:dot], mantissa[dot+1:]
+	}
+
+	strt := strings.TrimRight(strf, "0")
+
+	result := Operands{
+		I:        digits2uint(strint),
+		V:        len(strf),
+		W:        len(strt),
+		F:        digits2uint(strf),
+		T:        digits2uint(strt),
+		E:        e,
+		Negative: negative,
+	}
+
+	if "" == strf {
+		result.N, _ = strconv.ParseFloat(strint, 64)
+	} else {
+		result.N, _ = strconv.ParseFloat(strint+"."+strf, 64)
+	}
+	return result, nil
+}
+
+// Returns the position of the first character after the digits at `pos`
+func skipDigits(strval string, pos int) int {
+	for pos < len(strval) && '0' <= strval[pos] && strval[pos] <= '9' {
+		pos++
+	}
+	return pos
+}
+
+func compactOperands(value Compact) (Operands, error) {
+	mantissa, err := decimalString(value.Mantissa)
+	if nil != err {
+		return Operands{}, err
+	}
+	if -1 != strings.IndexAny(mantissa, "ceCE") {
+		return Operands{}, invalidValue(value, "mantissa with an exponent")
+	}
+
+	if value.Exponent < 0 || value.Exponent > max_exponent {
+		return Operands{}, invalidValue(value, fmt.Sprintf("exponent out of 0..%d", max_exponent))
+	}
+	return ParseOperands(mantissa + "c" + strconv.Itoa(value.Exponent))
+}
+
+// Returns a number written in decimal, as exactly as its type allows
+func decimalString(value interface{}) (string, error) {
+	switch value.(type) {
+	case string:
+		return value.(string), nil
+
+	case json.Number:
+		return jsonString(value.(json.Number))
+
+	case *big.Int:
+		if intval := value.(*big.Int); nil != intval {
+			return intval.String(), nil
+		}
+		return "", unsupportedType(value)
+
+	case *big.Float:
+		if floatval := value.(*big.Float); nil != floatval {
+			if floatval.IsInf() {
+				return "", invalidValue(value, "not a finite number")
+			}
+			return floatval.Text('f', -1), nil
+		}
+		return "", unsupportedType(value)
+
+	case *big.Rat:
+		if ratval := value.(*big.Rat); nil != ratval {
+			return ratString(ratval)
+		}
+		return "", unsupportedType(value)
+	}
+
+	// Any integer or float type, including the ones defined upon them
+	if nil != value {
+		numval := reflect.ValueOf(value)
+		switch numval.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return strconv.FormatInt(numval.Int(), 10), nil
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return strconv.FormatUint(numval.Uint(), 10), nil
+
+		case reflect.Float32, reflect.Float64:
+			floatval := numval.Float()
+			if math.IsNaN(floatval) || math.IsInf(floatval, 0) {
+				return "", invalidValue(value, "not a finite number")
+			}
+			// The shortest decimal which reads back as the same float32 or float64
+			return strconv.FormatFloat(floatval, 'f', -1, numval.Type().Bits()), nil
+
+		case reflect.Ptr:
+			if numval.IsNil() {
+				return "", unsupportedType(value)
+			}
+		}
+	}
+
+	if stringer, ok := value.(fmt.Stringer); ok {
+		return stringer.String(), nil
+	}
+	return "", unsupportedType(value)
+}
+
+// Returns a JSON number written in decimal, eg. "1.5e-3" => "0.0015": unlike
+// the ones of ParseOperands, its exponent is scientific notation, which
+// gives no `e` operand
+func jsonString(value json.Number) (string, error) {
+	strval := string(value)
+	pos := strings.IndexAny(strval, "eE")
+	if -1 == pos {
+		return strval, nil
+	}
+
+	fail := func(pos int, reason string) (string, error) {
+		return "", &NumberError{strval, pos, reason}
+	}
+
+	mantissa, sign := strval[:pos], ""
+	if strings.HasPrefix(mantissa, "-") {
+		mantissa, sign = mantissa[1:], "-"
+	}
+	if "" == mantissa || '.' == mantissa[0] {
+		return fail(len(sign), "missing integer digits")
+	}
+	integer, fraction := mantissa, ""
+	if dot := strings.IndexByte(mantissa, '.'); -1 != dot {
+		integer, fraction = mantissa[:dot], mantissa[dot+1:]
+		if "" == fraction {
+			return fail(pos, "missing fraction digits")
+		}
+	}
+	for idx := 0; idx < len(mantissa); idx++ {
+		// Anything but digits and the decimal point
+		if ('0' > mantissa[idx] || mantissa[idx] > '9') && idx != len(integer) {
+			char, _ := utf8.DecodeRuneInString(mantissa[idx:])
+			return fail(len(sign)+idx, fmt.Sprintf("unexpected character `%c`", char))
+		}
+	}
+
+	start := pos + 1
+	if start < len(strval) && ('-' == strval[start] || '+' == strval[start]) {
+		start++
+	}
+	end := skipDigits(strval, start)
+	switch {
+	case start == end && end == len(strval):
+		return fail(end, "missing exponent digits")
+	case end < len(strval):
+		char, _ := utf8.DecodeRuneInString(strval[end:])
+		return fail(end, fmt.Sprintf("unexpected character `%c`", char))
+	}
+
+	exponent, err := strconv.Atoi(strval[pos+1:])
+	if nil != err || exponent < -max_exponent || exponent > max_exponent {
+		return fail(pos+1, fmt.Sprintf("exponent out of -%d..%d", max_exponent, max_exponent))
+	}
+	// The exponent of the last digit of the mantissa
+	return sign + shiftDigits(integer+fraction, exponent-len(fraction)), nil
+}
+
+// Returns the decimal expansion of a rational number, as long as it is
+// finite, ie. its denominator has no other prime factors than 2 and 5
+func ratString(value *big.Rat) (string, error) {
+	denominator := new(big.Int).Set(value.Denom())
+	remainder := new(big.Int)
+
+	digits := 0
+	for _, factor := range []int64{2, 5} {
+		count := 0
+		divisor := big.NewInt(factor)
+		for {
+			quotient, modulus := new(big.Int).QuoRem(denominator, divisor, remainder)
+			if 0 != modulus.Sign() {
+				break
+			}
+			denominator = quotient
+			count++
+		}
+		if count > digits {
+			digits = count
+		}
+	}
+
+	if !denominator.IsInt64() || 1 != denominator.Int64() {
+		return "", invalidValue(value, "no finite decimal expansion")
+	}
+	return value.FloatString(digits), nil
+}
+
+// Exact value of `n` for the generated functions: its integer value, or
+// `fractional` when it has visible fraction digits other than zeros
+func (o Operands) n() uint64 {
+	if 0 != o.F || 0 != o.T {
+		return fractional
+	}
+	return o.I
+}
+
+func integerOperands(value int64) Operands {
+	var i uint64
+	if value < 0 {
+		// -(value + 1) does not overflow for math.MinInt64
+		i = uint64(-(value + 1)) + 1
+	} else {
+		i = uint64(value)
+	}
+
+	result := Operands{N: float64(i), I: i, Negative: value < 0}
+	if i > overflow {
+		result.I = i%overflow + overflow
+	}
+	return result
+}
+
+// Returns the value of a string of digits, reduced when greater than 10^18
+func digits2uint(strval string) uint64 {
+	strval = strings.TrimLeft(strval, "0")
+	if len(strval) > 18 {
+		value, _ := strconv.ParseUint(strval[len(strval)-18:], 10, 64)
+		return value + overflow
+	}
+
+	value, _ := strconv.ParseUint(strval, 10, 64)
+	return value
+}
@@ -0,0 +1,292 @@
+package plural
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func testOperands(test *testing.T, value interface{}, expected Operands) {
+	result, err := NewOperands(value)
+	if nil != err {
+		test.Errorf("`%v` : unexpected error %s", value, err)
+	} else if expected != result {
+		test.Errorf("`%v` :\n\texpected %+v\n\tbut got  %+v", value, expected, result)
+	} else if testing.Verbose() {
+		fmt.Printf("- Got expected operands for <%v>\n", value)
+	}
+}
+
+func TestOperands(t *testing.T) {
+	testOperands(t, -1, Operands{N: 1, I: 1, Negative: true})
+	testOperands(t, 1, Operands{N: 1, I: 1})
+	testOperands(t, "1.0", Operands{N: 1, I: 1, V: 1})
+	testOperands(t, "1.00", Operands{N: 1, I: 1, V: 2})
+	testOperands(t, 10.20, Operands{N: 10.2, I: 10, V: 1, W: 1, F: 2, T: 2})
+	testOperands(t, "10.20", Operands{N: 10.2, I: 10, V: 2, W: 1, F: 20, T: 2})
+	testOperands(t, -123, Operands{N: 123, I: 123, Negative: true})
+	testOperands(t, -123.990, Operands{N: 123.99, I: 123, V: 2, W: 2, F: 99, T: 99, Negative: true})
+	testOperands(t, "-123.990", Operands{N: 123.99, I: 123, V: 3, W: 2, F: 990, T: 99, Negative: true})
+	testOperands(t, 0.7+0.1, Operands{N: 0.8, I: 0, V: 1, W: 1, F: 8, T: 8})
+	testOperands(t, 123456.305, Operands{N: 123456.305, I: 123456, V: 3, W: 3, F: 305, T: 305})
+	testOperands(t, 123456.3057892, Operands{N: 123456.3057892, I: 123456, V: 7, W: 7, F: 3057892, T: 3057892})
+	testOperands(t, 123456.3057000, Operands{N: 123456.3057, I: 123456, V: 4, W: 4, F: 3057, T: 3057})
+	testOperands(t, "123456.3057000", Operands{N: 123456.3057, I: 123456, V: 7, W: 4, F: 3057000, T: 3057})
+	testOperands(t, 1000000000000, Operands{N: 1000000000000, I: 1000000000000})
+	testOperands(t, 0.33333, Operands{N: 0.33333, I: 0, V: 5, W: 5, F: 33333, T: 33333})
+
+	testOperands(t, "+3", Operands{N: 3, I: 3})
+	testOperands(t, " -3.50\n", Operands{N: 3.5, I: 3, V: 2, W: 1, F: 50, T: 5, Negative: true})
+	testOperands(t, "1.2c6", Operands{N: 1200000, I: 1200000, E: 6})
+	testOperands(t, "-1.25e1", Operands{N: 12.5, I: 12, V: 1, W: 1, F: 5, T: 5, E: 1, Negative: true})
+	testOperands(t, Operands{I: 7}, Operands{I: 7})
+
+	testOperands(t, Compact{1.2, 6}, Operands{N: 1200000, I: 1200000, E: 6})
+	testOperands(t, Compact{"1.20", 1}, Operands{N: 12, I: 12, V: 1, E: 1})
+	testOperands(t, Compact{"1.2345", 3}, Operands{N: 1234.5, I: 1234, V: 1, W: 1, F: 5, T: 5, E: 3})
+	testOperands(t, Compact{-5, 3}, Operands{N: 5000, I: 5000, E: 3, Negative: true})
+	testOperands(t, Compact{int64(2), 0}, Operands{N: 2, I: 2})
+	testOperands(t, "1.5E3", Operands{N: 1500, I: 1500, E: 3})
+
+	testOperands(t, int64(9007199254740993), Operands{N: 9007199254740993, I: 9007199254740993})
+	testOperands(t, "9007199254740993.50", Operands{N: 9007199254740993.5, I: 9007199254740993, V: 2, W: 1, F: 50, T: 5})
+	testOperands(t, "1.0000000000000001", Operands{N: 1, I: 1, V: 16, W: 16, F: 1, T: 1})
+
+	// Greater than 10^18: kept modulo 10^18, plus 10^18
+	testOperands(t, int64(math.MinInt64), Operands{N: 9223372036854775808, I: 1223372036854775808, Negative: true})
+	testOperands(t, "123456789012345678901", Operands{N: 123456789012345678901, I: 1456789012345678901})
+	testOperands(t, "1000000000000000000", Operands{N: 1e18, I: 1000000000000000000})
+	testOperands(t, "0.10000000000000000001000", Operands{N: 0.1, V: 23, W: 20, F: 1000000000000001000, T: 1000000000000000001})
+	testOperands(t, "0.000000000000000000001", Operands{N: 1e-21, V: 21, W: 21, F: 1, T: 1})
+}
+
+func TestOperandsErrors(t *testing.T) {
+	for _, value := range []interface{}{Compact{"1c2", 3}, Compact{1, -1}, Compact{1, 1001}, Compact{math.NaN(), 1}, Compact{nil, 1},
+		"", "abc", "1.", ".5", "1..2", "--1", "1 000", "0x10", "1cx", "1c-1", "1c2c3", math.NaN(), math.Inf(1), math.Inf(-1), []int{1}, nil} {
+		if result, err := NewOperands(value); nil == err {
+			t.Errorf("`%v` : expected an error but got %+v", value, result)
+		} else if (Operands{}) != result {
+			t.Errorf("`%v` : expected zero operands but got %+v", value, result)
+		}
+	}
+}
+
+func testNumberError(test *testing.T, value interface{}, expected NumberError, message string) {
+	_, err := NewOperands(value)
+
+	var result *NumberError
+	if !errors.As(err, &result) {
+		test.Errorf("`%v` : expected a NumberError but got %v", value, err)
+	} else if expected != *result {
+		test.Errorf("`%v` :\n\texpected %+v\n\tbut got  %+v", value, expected, *result)
+	} else if message != err.Error() {
+		test.Errorf("`%v` : expected %s but got %s", value, message, err)
+	} else if !errors.Is(err, ErrInvalidNumber) {
+		test.Errorf("`%v` : expected ErrInvalidNumber to match", value)
+	} else if testing.Verbose() {
+		fmt.Printf("- Got expected error for <%v>\n", value)
+	}
+}
+
+func TestNumberError(t *testing.T) {
+	testNumberError(t, "1,5", NumberError{"1,5", 1, "unexpected character `,`"}, "InvalidNumber: `1,5` (unexpected character `,` at position 1)")
+	testNumberError(t, "abc", NumberError{"abc", 0, "unexpected character `a`"}, "InvalidNumber: `abc` (unexpected character `a` at position 0)")
+	testNumberError(t, "  -1.2x", NumberError{"  -1.2x", 6, "unexpected character `x`"}, "InvalidNumber: `  -1.2x` (unexpected character `x` at position 6)")
+	testNumberError(t, "1.5€", NumberError{"1.5€", 3, "unexpected character `€`"}, "InvalidNumber: `1.5€` (unexpected character `€` at position 3)")
+	testNumberError(t, "-.5", NumberError{"-.5", 1, "missing integer digits"}, "InvalidNumber: `-.5` (missing integer digits at position 1)")
+	testNumberError(t, "1.", NumberError{"1.", 2, "missing fraction digits"}, "InvalidNumber: `1.` (missing fraction digits at position 2)")
+	testNumberError(t, "1c", NumberError{"1c", 2, "missing exponent digits"}, "InvalidNumber: `1c` (missing exponent digits at position 2)")
+	testNumberError(t, "1c-1", NumberError{"1c-1", 2, "unexpected character `-`"}, "InvalidNumber: `1c-1` (unexpected character `-` at position 2)")
+	testNumberError(t, "1c1001", NumberError{"1c1001", 2, "exponent greater than 1000"}, "InvalidNumber: `1c1001` (exponent greater than 1000 at position 2)")
+	testNumberError(t, " ", NumberError{" ", -1, "empty value"}, "InvalidNumber: ` ` (empty value)")
+	testNumberError(t, math.NaN(), NumberError{"NaN", -1, "not a finite number"}, "InvalidNumber: `NaN` (not a finite number)")
+	testNumberError(t, math.Inf(-1), NumberError{"-Inf", -1, "not a finite number"}, "InvalidNumber: `-Inf` (not a finite number)")
+	testNumberError(t, []int{1}, NumberError{"[1]", -1, "unsupported type `[]int`"}, "InvalidNumber: `[1]` (unsupported type `[]int`)")
+}
+
+func testExact(test *testing.T, culture string, value interface{}, ordinal bool, expected string) {
+	if !hasCultures(culture) {
+		return
+	}
+
+	fn, err := GetFunc(culture)
+	if nil != err {
+		test.Error(err)
+		return
+	}
+
+	if result := fn(value, ordinal); expected != result {
+		test.Errorf("%s `%v` : expected `%s` but got `%s`", culture, value, expected, result)
+	} else if testing.Verbose() {
+		fmt.Printf("- %s <%v> is `%s`\n", culture, value, result)
+	}
+}
+
+func TestExactArithmetic(t *testing.T) {
+	// 9007199254740993 (2^53 + 1) would be rounded to 9007199254740992 as a float
+	testExact(t, "en", int64(9007199254740993), true, "few")
+	testExact(t, "en", "9007199254740993", true, "few")
+	testExact(t, "en", int64(9007199254740992), true, "two")
+	testExact(t, "ru", int64(9007199254740993), false, "few")
+	testExact(t, "ar", "9007199254740903", false, "few")
+	testExact(t, "ar", "9007199254740903.5", false, "other")
+
+	// Decimals that cannot be represented as a float64
+	testExact(t, "ak", "1.0000000000000001", false, "other")
+	testExact(t, "ak", "1.0000000000000000", false, "one")
+
+	// Beyond uint64, only the remainders of the operands matter
+	testExact(t, "ru", "123456789012345678901", false, "one")
+	testExact(t, "ru", "123456789012345678911", false, "many")
+	testExact(t, "en", "100000000000000000001", false, "other")
+	testExact(t, "lv", "0.10000000000000000001", false, "one")
+	testExact(t, "lv", "0.00000000000000000000", false, "zero")
+
+	// Rules apply to the absolute value
+	testExact(t, "en", -1, false, "one")
+	testExact(t, "ru", "-21", false, "one")
+}
+
+func TestOperandsFunc(t *testing.T) {
+	skipWithout(t, "pl")
+	fn, err := GetOperandsFunc("pl")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	ops, err := ParseOperands("22")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(ops, false); Few != result {
+		t.Errorf("pl `22` : expected `few` but got `%s`", result)
+	}
+
+	ops, err = NewOperands(22.5)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if result := fn(ops, false); Other != result {
+		t.Errorf("pl `22.5` : expected `other` but got `%s`", result)
+	}
+}
+
+func TestCompactInput(t *testing.T) {
+	// 1.2 million is not `one` in French, though its mantissa would be
+	testExact(t, "fr", "1.2c6", false, "other")
+	testExact(t, "fr", "1.2e6", false, "other")
+	testExact(t, "fr", Compact{1.2, 6}, false, "other")
+	testExact(t, "fr", Compact{1.2, 0}, false, "one")
+	testExact(t, "ru", Compact{"2.1", 1}, false, "one")
+	testExact(t, "ru", "2.1c1", false, "one")
+}
+
+func bigInt(value string) *big.Int {
+	result, _ := new(big.Int).SetString(value, 10)
+	return result
+}
+
+func bigFloat(value string) *big.Float {
+	result, _, _ := big.ParseFloat(value, 10, 200, big.ToNearestEven)
+	return result
+}
+
+func bigRat(value string) *big.Rat {
+	result, _ := new(big.Rat).SetString(value)
+	return result
+}
+
+func TestBigOperands(t *testing.T) {
+	testOperands(t, big.NewInt(-21), Operands{N: 21, I: 21, Negative: true})
+	testOperands(t, bigInt("123456789012345678901234567890"), Operands{N: 123456789012345678901234567890, I: 345678901234567890 + overflow})
+	testOperands(t, big.NewFloat(1.5), Operands{N: 1.5, I: 1, V: 1, W: 1, F: 5, T: 5})
+	testOperands(t, bigFloat("1.0000000000000000000001"), Operands{N: 1, I: 1, V: 22, W: 22, F: 1, T: 1})
+	testOperands(t, big.NewRat(3, 4), Operands{N: 0.75, V: 2, W: 2, F: 75, T: 75})
+	testOperands(t, big.NewRat(-7, 1), Operands{N: 7, I: 7, Negative: true})
+	testOperands(t, bigRat("1/1024"), Operands{N: 0.0009765625, V: 10, W: 10, F: 9765625, T: 9765625})
+	testOperands(t, bigRat("12345678901234567890123/1000"), Operands{N: 12345678901234567890.123, I: 345678901234567890 + overflow, V: 3, W: 3, F: 123, T: 123})
+	testOperands(t, Compact{big.NewRat(12, 10), 6}, Operands{N: 1200000, I: 1200000, E: 6})
+
+	testOperands(t, "1.0000000000000000000001", Operands{N: 1, I: 1, V: 22, W: 22, F: 1, T: 1})
+	testOperands(t, "3."+fmt.Sprintf("%0100d", 7), Operands{N: 3, I: 3, V: 100, W: 100, F: 7, T: 7})
+
+	for _, value := range []interface{}{big.NewRat(1, 3), big.NewRat(1, 6), new(big.Float).SetInf(false), (*big.Int)(nil), (*big.Float)(nil), (*big.Rat)(nil), big.Int{}} {
+		if result, err := NewOperands(value); nil == err {
+			t.Errorf("`%v` : expected an error but got %+v", value, result)
+		}
+	}
+}
+
+func TestBigArithmetic(t *testing.T) {
+	// Long decimals have a `one` fraction, not an overflow into zeros
+	testExact(t, "lv", "1.0000000000000000000001", false, "one")
+	testExact(t, "lv", bigFloat("0.0000000000000000000000000001"), false, "one")
+	testExact(t, "ru", bigInt("100000000000000000000000000000000000000021"), false, "one")
+	testExact(t, "ru", bigInt("-100000000000000000000000000000000000000011"), false, "many")
+	testExact(t, "en", big.NewRat(1, 1), false, "one")
+	testExact(t, "en", big.NewRat(3, 2), false, "other")
+	testExact(t, "pl", big.NewRat(22, 1), false, "few")
+}
+
+type count uint16
+
+type price float32
+
+type label string
+
+func (l label) String() string {
+	return string(l)
+}
+
+func TestNumberKinds(t *testing.T) {
+	testOperands(t, int8(-21), Operands{N: 21, I: 21, Negative: true})
+	testOperands(t, int16(21), Operands{N: 21, I: 21})
+	testOperands(t, int32(21), Operands{N: 21, I: 21})
+	testOperands(t, uint(21), Operands{N: 21, I: 21})
+	testOperands(t, uint8(21), Operands{N: 21, I: 21})
+	testOperands(t, uint16(21), Operands{N: 21, I: 21})
+	testOperands(t, uint32(21), Operands{N: 21, I: 21})
+	testOperands(t, uint64(math.MaxUint64), Operands{N: math.MaxUint64, I: 446744073709551615 + overflow})
+	testOperands(t, uintptr(21), Operands{N: 21, I: 21})
+	testOperands(t, float32(1.1), Operands{N: 1.1, I: 1, V: 1, W: 1, F: 1, T: 1})
+	testOperands(t, count(21), Operands{N: 21, I: 21})
+	testOperands(t, price(2.5), Operands{N: 2.5, I: 2, V: 1, W: 1, F: 5, T: 5})
+	testOperands(t, json.Number("1.50"), Operands{N: 1.5, I: 1, V: 2, W: 1, F: 50, T: 5})
+	testOperands(t, label("3.0"), Operands{N: 3, I: 3, V: 1})
+
+	for _, value := range []interface{}{float32(math.Inf(1)), price(math.NaN()), json.Number("1,5"), label("three"), (*label)(nil), true, struct{}{}} {
+		if result, err := NewOperands(value); nil == err {
+			t.Errorf("`%v` : expected an error but got %+v", value, result)
+		}
+	}
+
+	// Each of them used to be handled as 0
+	testExact(t, "ru", uint32(21), false, "one")
+	testExact(t, "ru", int16(22), false, "few")
+	testExact(t, "lv", float32(0.1), false, "one")
+	testExact(t, "lv", json.Number("0.10"), false, "other")
+}
+
+func TestJSONNumber(t *testing.T) {
+	// Exponents of JSON numbers are scientific notation, not compact decimals
+	testOperands(t, json.Number("1e3"), Operands{N: 1000, I: 1000})
+	testOperands(t, json.Number("1E+2"), Operands{N: 100, I: 100})
+	testOperands(t, json.Number("1e-3"), Operands{N: 0.001, V: 3, W: 3, F: 1, T: 1})
+	testOperands(t, json.Number("-1.50e1"), Operands{N: 15, I: 15, V: 1, Negative: true})
+	testOperands(t, json.Number("12.5e-1"), Operands{N: 1.25, I: 1, V: 2, W: 2, F: 25, T: 25})
+	testOperands(t, json.Number("0.0e0"), Operands{V: 1})
+	testExact(t, "fr", json.Number("1.2e6"), false, "other")
+	testExact(t, "fr", json.Number("12e-1"), false, "one")
+
+	testNumberError(t, json.Number("1e"), NumberError{"1e", 2, "missing exponent digits"}, "InvalidNumber: `1e` (missing exponent digits at position 2)")
+	testNumberError(t, json.Number("1e+"), NumberError{"1e+", 3, "missing exponent digits"}, "InvalidNumber: `1e+` (missing exponent digits at position 3)")
+	testNumberError(t, json.Number("-.5e1"), NumberError{"-.5e1", 1, "missing integer digits"}, "InvalidNumber: `-.5e1` (missing integer digits at position 1)")
+	testNumberError(t, json.Number("1.e1"), NumberError{"1.e1", 2, "missing fraction digits"}, "InvalidNumber: `1.e1` (missing fraction digits at position 2)")
+	testNumberError(t, json.Number("1.2.3e1"), NumberError{"1.2.3e1", 3, "unexpected character `.`"}, "InvalidNumber: `1.2.3e1` (unexpected character `.` at position 3)")
+	testNumberError(t, json.Number("1c2e3"), NumberError{"1c2e3", 1, "unexpected character `c`"}, "InvalidNumber: `1c2e3` (unexpected character `c` at position 1)")
+	testNumberError(t, json.Number("1e2x"), NumberError{"1e2x", 3, "unexpected character `x`"}, "InvalidNumber: `1e2x` (unexpected character `x` at position 3)")
+	testNumberError(t, json.Number("1e-1001"), NumberError{"1e-1001", 2, "exponent out of -1000..1000"}, "InvalidNumber: `1e-1001` (exponent out of -1000..1000 at position 2)")
+}
@@ -0,0 +1,303 @@
+package plural
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Options of NewRules, named after the ones of ECMA-402 Intl.PluralRules:
+// the number is first rounded and formatted (eg. 1 as "1.0" with a minimum
+// of 1 fraction digit), then its category is the one of its formatted value.
+// Unset (nil) digits take the same defaults as in JavaScript.
+type Options struct {
+	// "cardinal" (default) or "ordinal"
+	Type string `json:"type,omitempty"`
+	// Between 0 and 100, 0 and 3 by default
+	MinimumFractionDigits *int `json:"minimumFractionDigits,omitempty"`
+	MaximumFractionDigits *int `json:"maximumFractionDigits,omitempty"`
+	// Between 1 and 21, both unset by default: when any of them is set, the
+	// fraction digits are ignored
+	MinimumSignificantDigits *int `json:"minimumSignificantDigits,omitempty"`
+	MaximumSignificantDigits *int `json:"maximumSignificantDigits,omitempty"`
+	// "ceil", "floor", "expand", "trunc", "halfCeil", "halfFloor",
+	// "halfExpand" (default), "halfTrunc" or "halfEven"
+	RoundingMode string `json:"roundingMode,omitempty"`
+}
+
+// Options of a Rules once their defaults are applied, along with its culture
+type ResolvedOptions struct {
+	Locale string `json:"locale"`
+	Options
+}
+
+// Plural rules of a culture, applied to numbers formatted as ECMA-402 does
+type Rules struct {
+	fn      OperandsFunc
+	ordinal bool
+	options ResolvedOptions
+	// Whether the significant digits are rounded instead of the fraction digits
+	significant bool
+	// Number of digits kept when rounding, and least number of them once trimmed
+	min_digits, max_digits int
+}
+
+// Limits of the digits options
+const (
+	max_fraction_digits    = 100
+	max_significant_digits = 21
+)
+
+// Directions of the rounding modes, for positive and negative numbers
+var rounding_modes = map[string][2]roundingMode{
+	"ceil":       {toInfinity, toZero},
+	"floor":      {toZero, toInfinity},
+	"expand":     {toInfinity, toInfinity},
+	"trunc":      {toZero, toZero},
+	"halfCeil":   {halfToInfinity, halfToZero},
+	"halfFloor":  {halfToZero, halfToInfinity},
+	"halfExpand": {halfToInfinity, halfToInfinity},
+	"halfTrunc":  {halfToZero, halfToZero},
+	"halfEven":   {halfToEven, halfToEven},
+}
+
+// Rounding of an absolute value
+type roundingMode int
+
+const (
+	toZero roundingMode = iota
+	toInfinity
+	halfToZero
+	halfToInfinity
+	halfToEven
+)
+
+// Returns a pointer to a number of digits, to set Options fields
+func Digits(value int) *int {
+	return &value
+}
+
+// Returns the plural rules of a locale (see Resolve), or an error if any
+// option is invalid. As with Ordinal, an "ordinal" type gives an error
+// matching ErrNoOrdinal when neither the culture nor its parents have ordinal
+// rules.
+func NewRules(locale string, options Options) (*Rules, error) {
+	culture, err := Resolve(locale)
+	if nil != err {
+		return nil, err
+	}
+
+	result := &Rules{fn: plural_funcs[culture]}
+	resolved := Options{Type: options.Type, RoundingMode: options.RoundingMode}
+
+	switch options.Type {
+	case "", "cardinal":
+		resolved.Type = "cardinal"
+	case "ordinal":
+		// The ordinal rules may be the ones of a parent culture
+		if culture, err = resolveOrdinal(locale); nil != err {
+			return nil, err
+		}
+		result.fn, result.ordinal = plural_funcs[culture], true
+	default:
+		return nil, fmt.Errorf("InvalidOption: `type` (%s)", options.Type)
+	}
+
+	if "" == options.RoundingMode {
+		resolved.RoundingMode = "halfExpand"
+	} else if _, ok := rounding_modes[options.RoundingMode]; !ok {
+		return nil, fmt.Errorf("InvalidOption: `roundingMode` (%s)", options.RoundingMode)
+	}
+
+	if nil != options.MinimumSignificantDigits || nil != options.MaximumSignificantDigits {
+		result.significant = true
+		result.min_digits, result.max_digits, err = digitsRange(
+			"SignificantDigits", options.MinimumSignificantDigits, options.MaximumSignificantDigits, 1, max_significant_digits, 1, max_significant_digits)
+		resolved.MinimumSignificantDigits = Digits(result.min_digits)
+		resolved.MaximumSignificantDigits = Digits(result.max_digits)
+	} else {
+		result.min_digits, result.max_digits, err = digitsRange(
+			"FractionDigits", options.MinimumFractionDigits, options.MaximumFractionDigits, 0, max_fraction_digits, 0, 3)
+		resolved.MinimumFractionDigits = Digits(result.min_digits)
+		resolved.MaximumFractionDigits = Digits(result.max_digits)
+	}
+	if nil != err {
+		return nil, err
+	}
+
+	result.options = ResolvedOptions{culture, resolved}
+	return result, nil
+}
+
+// Returns the minimum and maximum of a pair of digits options, applying the
+// defaults of ECMA-402 when any of them is unset
+func digitsRange(name string, minimum, maximum *int, lower, upper, default_min, default_max int) (int, int, error) {
+	for prefix, value := range map[string]*int{"minimum": minimum, "maximum": maximum} {
+		if nil != value && (*value < lower || *value > upper) {
+			return 0, 0, fmt.Errorf("InvalidOption: `%s%s` (%d is out of %d..%d)", prefix, name, *value, lower, upper)
+		}
+	}
+
+	switch {
+	case nil == minimum && nil == maximum:
+		return default_min, default_max, nil
+
+	case nil == minimum:
+		if *maximum < default_min {
+			return *maximum, *maximum, nil
+		}
+		return default_min, *maximum, nil
+
+	case nil == maximum:
+		if *minimum > default_max {
+			return *minimum, *minimum, nil
+		}
+		return *minimum, default_max, nil
+
+	case *minimum > *maximum:
+		return 0, 0, fmt.Errorf("InvalidOption: `minimum%s` (%d is greater than %d)", name, *minimum, *maximum)
+	}
+	return *minimum, *maximum, nil
+}
+
+// Returns the options in use, their defaults included
+func (r *Rules) ResolvedOptions() ResolvedOptions {
+	return r.options
+}
+
+// Returns the category of a number once formatted, eg. with a minimum of 1
+// fraction digit 1 is formatted as "1.0", which is `other` in English.
+// As in JavaScript, NaN and infinities are `other`.
+func (r *Rules) Select(value float64) Category {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Other
+	}
+
+	ops, _ := ParseOperands(r.format(value))
+	return r.fn(ops, r.ordinal)
+}
+
+// Returns a number rounded and formatted according to the options, without
+// any grouping separator nor exponent, eg. "1234.50"
+func (r *Rules) format(value float64) string {
+	negative := math.Signbit(value)
+	mode := rounding_modes[r.options.RoundingMode][0]
+	if negative {
+		mode = rounding_modes[r.options.RoundingMode][1]
+	}
+
+	// As browsers do, the shortest decimal which reads back as the same
+	// float is rounded (1.005 is "1.01" with 2 fraction digits), written
+	// as its digits and the number of them before the decimal point
+	strval := strconv.FormatFloat(math.Abs(value), 'e', -1, 64)
+	pos := strings.IndexByte(strval, 'e')
+	exponent, _ := strconv.Atoi(strval[pos+1:])
+	digits := strings.Replace(strval[:pos], ".", "", 1)
+	point := exponent + 1
+
+	// Exponent of the last digit kept
+	last := -r.max_digits
+	if r.significant {
+		last = point - r.max_digits
+	}
+
+	kept := roundDigits(digits, point-last, mode)
+	if r.significant && len(kept) > point-last {
+		// 9.99 rounded to 2 significant digits is "10", not "10.0"
+		kept = kept[:len(kept)-1]
+		last++
+	}
+	result := shiftDigits(kept, last)
+
+	// Trailing zeros are removed, down to the minimum number of digits
+	if strings.Contains(result, ".") {
+		for cut := r.max_digits - r.min_digits; cut > 0 && strings.HasSuffix(result, "0"); cut-- {
+			result = result[:len(result)-1]
+		}
+		result = strings.TrimSuffix(result, ".")
+	}
+
+	if negative {
+		return "-" + result
+	}
+	return result
+}
+
+// Returns the first `count` digits (which may be none, or more than there
+// are) of a number, rounded according to the ones that follow
+func roundDigits(digits string, count int, mode roundingMode) string {
+	if count >= len(digits) {
+		return digits + strings.Repeat("0", count-len(digits))
+	}
+
+	kept, rest := "", digits
+	if count > 0 {
+		kept, rest = digits[:count], digits[count:]
+	} else {
+		// Digits beyond the first dropped one: less than a half
+		rest = strings.Repeat("0", -count) + rest
+	}
+
+	up := false
+	if "" != strings.Trim(rest, "0") {
+		switch mode {
+		case toInfinity:
+			up = true
+
+		case halfToZero, halfToInfinity, halfToEven:
+			switch {
+			case rest[0] > '5', '5' == rest[0] && "" != strings.Trim(rest[1:], "0"):
+				up = true
+			case rest[0] < '5':
+				up = false
+			case halfToInfinity == mode:
+				up = true
+			case halfToEven == mode:
+				up = "" != kept && 1 == (kept[len(kept)-1]-'0')%2
+			}
+		}
+	}
+
+	if up {
+		return incrementDigits(kept)
+	}
+	if "" == kept {
+		return "0"
+	}
+	return kept
+}
+
+// Returns a string of digits plus one, eg. "199" => "200", "" => "1"
+func incrementDigits(digits string) string {
+	result := []byte(digits)
+	for pos := len(result) - 1; pos >= 0; pos-- {
+		if '9' != result[pos] {
+			result[pos]++
+			return string(result)
+		}
+		result[pos] = '0'
+	}
+	return "1" + string(result)
+}
+
+// Returns the decimal value of digits whose last one has the given exponent,
+// eg. "1205" and -2 => "12.05"
+func shiftDigits(digits string, exponent int) string {
+	if exponent >= 0 {
+		digits += strings.Repeat("0", exponent)
+	} else if len(digits) <= -exponent {
+		digits = strings.Repeat("0", 1-exponent-len(digits)) + digits
+	}
+
+	if exponent < 0 {
+		pos := len(digits) + exponent
+		digits = digits[:pos] + "." + digits[pos:]
+	}
+
+	trimmed := strings.TrimLeft(digits, "0")
+	if "" == trimmed || '.' == trimmed[0] {
+		return "0" + trimmed
+	}
+	return trimmed
+}
@@ -0,0 +1,146 @@
+package plural
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func describe(options Options) string {
+	digits := func(value *int) string {
+		if nil == value {
+			return "-"
+		}
+		return fmt.Sprint(*value)
+	}
+	return fmt.Sprintf("%s %s..%s fraction %s..%s significant %s", options.Type,
+		digits(options.MinimumFractionDigits), digits(options.MaximumFractionDigits),
+		digits(options.MinimumSignificantDigits), digits(options.MaximumSignificantDigits), options.RoundingMode)
+}
+
+func testRules(test *testing.T, locale string, options Options) *Rules {
+	result, err := NewRules(locale, options)
+	if nil != err {
+		test.Fatal(err)
+	}
+	return result
+}
+
+func testFormat(test *testing.T, options Options, value float64, expected string) {
+	if result := testRules(test, "en", options).format(value); expected != result {
+		test.Errorf("`%v` %+v : expected `%s` but got `%s`", value, describe(options), expected, result)
+	} else if testing.Verbose() {
+		fmt.Printf("- <%v> is formatted as `%s`\n", value, result)
+	}
+}
+
+func TestRulesFormat(t *testing.T) {
+	skipWithout(t, "en")
+	testFormat(t, Options{}, 1, "1")
+	testFormat(t, Options{}, 1.0004, "1")
+	testFormat(t, Options{}, 1.2345, "1.235")
+	testFormat(t, Options{}, -0.5, "-0.5")
+	testFormat(t, Options{}, 1e21, "1000000000000000000000")
+	testFormat(t, Options{MinimumFractionDigits: Digits(1)}, 1, "1.0")
+	testFormat(t, Options{MinimumFractionDigits: Digits(2)}, 1.5, "1.50")
+	testFormat(t, Options{MaximumFractionDigits: Digits(2)}, 1.005, "1.01")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0)}, 1.5, "2")
+	testFormat(t, Options{MaximumFractionDigits: Digits(1)}, 0.96, "1")
+	testFormat(t, Options{MinimumFractionDigits: Digits(1), MaximumFractionDigits: Digits(1)}, 0.96, "1.0")
+
+	testFormat(t, Options{MaximumSignificantDigits: Digits(2)}, 1234.5, "1200")
+	testFormat(t, Options{MaximumSignificantDigits: Digits(2)}, 9.99, "10")
+	testFormat(t, Options{MinimumSignificantDigits: Digits(2), MaximumSignificantDigits: Digits(2)}, 9.99, "10")
+	testFormat(t, Options{MinimumSignificantDigits: Digits(3)}, 1, "1.00")
+	testFormat(t, Options{MinimumSignificantDigits: Digits(3)}, 0, "0.00")
+	testFormat(t, Options{MaximumSignificantDigits: Digits(2)}, 0.000123, "0.00012")
+	testFormat(t, Options{MaximumSignificantDigits: Digits(1), MaximumFractionDigits: Digits(5)}, 1.5, "2")
+
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "halfEven"}, 2.5, "2")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "halfEven"}, 3.5, "4")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "halfEven"}, 0.5, "0")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0)}, -2.5, "-3")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "halfCeil"}, -2.5, "-2")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "halfFloor"}, 2.5, "2")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "halfTrunc"}, 2.51, "3")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "ceil"}, 1.1, "2")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "ceil"}, -1.1, "-1")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "floor"}, -1.1, "-2")
+	testFormat(t, Options{MaximumFractionDigits: Digits(0), RoundingMode: "trunc"}, 1.9, "1")
+	testFormat(t, Options{RoundingMode: "expand"}, 0.0004, "0.001")
+	testFormat(t, Options{RoundingMode: "trunc"}, 0.0004, "0")
+	testFormat(t, Options{}, 0.0005, "0.001")
+}
+
+func testSelect(test *testing.T, locale string, options Options, value float64, expected Category) {
+	if !hasCultures(language(locale)) {
+		return
+	}
+
+	if result := testRules(test, locale, options).Select(value); expected != result {
+		test.Errorf("%s `%v` %+v : expected `%s` but got `%s`", locale, value, describe(options), expected, result)
+	} else if testing.Verbose() {
+		fmt.Printf("- %s <%v> is `%s`\n", locale, value, result)
+	}
+}
+
+func TestRulesSelect(t *testing.T) {
+	defer withEntries(&plural_parents, cldr_parents)()
+	testSelect(t, "en", Options{}, 1, One)
+	testSelect(t, "en", Options{MinimumFractionDigits: Digits(1)}, 1, Other)
+	testSelect(t, "en", Options{MaximumFractionDigits: Digits(0)}, 1.4, One)
+	testSelect(t, "en", Options{MaximumSignificantDigits: Digits(1)}, 0.96, One)
+	testSelect(t, "sl", Options{}, 0, Other)
+	testSelect(t, "sl", Options{MinimumFractionDigits: Digits(1)}, 0, Few)
+	testSelect(t, "en", Options{Type: "ordinal"}, 22, Two)
+	testSelect(t, "en", Options{Type: "ordinal", MaximumFractionDigits: Digits(0)}, 2.6, Few)
+	// Ordinal rules of pt, the cardinal ones of pt-PT
+	testSelect(t, "pt-AO", Options{Type: "ordinal"}, 1, Other)
+	testSelect(t, "pt-AO", Options{}, 1, One)
+	testSelect(t, "en", Options{}, math.NaN(), Other)
+	testSelect(t, "en", Options{}, math.Inf(-1), Other)
+}
+
+func TestResolvedOptions(t *testing.T) {
+	skipWithout(t, "en")
+	for options, expected := range map[*Options]string{
+		{}:                                       "en cardinal 0..3 fraction -..- significant halfExpand",
+		{MinimumFractionDigits: Digits(5)}:       "en cardinal 5..5 fraction -..- significant halfExpand",
+		{MaximumFractionDigits: Digits(0)}:       "en cardinal 0..0 fraction -..- significant halfExpand",
+		{MaximumFractionDigits: Digits(1)}:       "en cardinal 0..1 fraction -..- significant halfExpand",
+		{MaximumSignificantDigits: Digits(3)}:    "en cardinal -..- fraction 1..3 significant halfExpand",
+		{MinimumSignificantDigits: Digits(2)}:    "en cardinal -..- fraction 2..21 significant halfExpand",
+		{Type: "ordinal", RoundingMode: "floor"}: "en ordinal 0..3 fraction -..- significant floor",
+	} {
+		resolved := testRules(t, "en-GB", *options).ResolvedOptions()
+		if result := resolved.Locale + " " + describe(resolved.Options); expected != result {
+			t.Errorf("%s : expected `%s` but got `%s`", describe(*options), expected, result)
+		}
+	}
+}
+
+func TestRulesErrors(t *testing.T) {
+	skipWithout(t, "en", "be")
+	for _, options := range []Options{
+		{Type: "plural"},
+		{RoundingMode: "up"},
+		{MaximumFractionDigits: Digits(101)},
+		{MinimumFractionDigits: Digits(-1)},
+		{MinimumFractionDigits: Digits(3), MaximumFractionDigits: Digits(2)},
+		{MinimumSignificantDigits: Digits(0)},
+		{MaximumSignificantDigits: Digits(22)},
+		{MinimumSignificantDigits: Digits(3), MaximumSignificantDigits: Digits(2)},
+	} {
+		if _, err := NewRules("en", options); nil == err {
+			t.Errorf("%+v : expected an error", describe(options))
+		}
+	}
+
+	if _, err := NewRules("be", Options{Type: "ordinal"}); !errors.Is(err, ErrNoOrdinal) {
+		t.Errorf("be : expected ErrNoOrdinal but got %v", err)
+	}
+	if _, err := NewRules("xx", Options{}); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("`xx` : expected ErrUnknownLocale but got %v", err)
+	}
+}